@@ -0,0 +1,169 @@
+package v1alpha1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/diranged/oz/internal/webhook"
+)
+
+var _ = Describe("AccessApproval", func() {
+	// This Context() tests specific functions - no real calls against the API
+	// are made here, the webhooks are handed a fake client instead.
+	Context("Functional Unit Tests", func() {
+		var (
+			approval  *AccessApproval
+			fakeCtx   context.Context
+			requester = "alice"
+		)
+
+		BeforeEach(func() {
+			request := &ExecAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: ExecAccessRequestSpec{
+					TemplateName: "test",
+					RequestedBy:  &RequesterInfo{Username: requester},
+				},
+			}
+			fakeCtx = webhook.NewContextWithClient(
+				context.Background(),
+				fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(request).Build(),
+			)
+
+			approval = &AccessApproval{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: AccessApprovalSpec{
+					RequestName:    "test",
+					RequestKind:    "ExecAccessRequest",
+					Decision:       ApprovalDecisionApproved,
+					Approver:       "forged",
+					ApproverGroups: []string{"forged"},
+				},
+			}
+		})
+
+		It("Default() should record the approver identity on create", func() {
+			err := approval.Default(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						Groups:   []string{"admins"},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(approval.Spec.Approver).To(Equal("admin"))
+			Expect(approval.Spec.ApproverGroups).To(Equal([]string{"admins"}))
+		})
+
+		It("Default() should not touch the approver identity on update", func() {
+			err := approval.Default(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Update,
+					UserInfo:  authenticationv1.UserInfo{Username: "admin"},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(approval.Spec.Approver).To(Equal("forged"))
+		})
+
+		It("ValidateCreate() should require a user identity", func() {
+			_, err := approval.ValidateCreate(fakeCtx, admission.Request{})
+			Expect(err).To(HaveOccurred())
+
+			_, err = approval.ValidateCreate(fakeCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "admin"},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("ValidateCreate() should reject the requester approving their own request", func() {
+			approval.Spec.Approver = requester
+			_, err := approval.ValidateCreate(fakeCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: requester},
+				},
+			})
+			Expect(err).To(MatchError(ContainSubstring("cannot approve their own request")))
+
+			By("Allowing approvals for requests that do not exist")
+			approval.Spec.RequestName = "missing"
+			_, err = approval.ValidateCreate(fakeCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: requester},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("ValidateCreate() should reject unknown request kinds", func() {
+			approval.Spec.RequestKind = "ConfigMap"
+			_, err := approval.ValidateCreate(fakeCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "admin"},
+				},
+			})
+			Expect(err).To(MatchError(ContainSubstring("is not a known Access Request kind")))
+		})
+
+		It("ValidateUpdate() should reject Spec changes", func() {
+			updated := approval.DeepCopy()
+			updated.SetAnnotations(map[string]string{"foo": "bar"})
+			_, err := updated.ValidateUpdate(webhookCtx, admission.Request{}, approval)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated.Spec.Decision = ApprovalDecisionDenied
+			_, err = updated.ValidateUpdate(webhookCtx, admission.Request{}, approval)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("ValidateUpdate() should reject an old object of another type", func() {
+			_, err := approval.ValidateUpdate(webhookCtx, admission.Request{}, &ExecAccessRequest{})
+			Expect(err).To(MatchError(ContainSubstring("expected an AccessApproval")))
+		})
+
+		It("IsFor() and IsApproved() should behave", func() {
+			Expect(approval.IsFor("ExecAccessRequest", "test")).To(BeTrue())
+			Expect(approval.IsFor("PodAccessRequest", "test")).To(BeFalse())
+			Expect(approval.IsFor("ExecAccessRequest", "other")).To(BeFalse())
+			Expect(approval.IsApproved()).To(BeTrue())
+
+			approval.Spec.Decision = ApprovalDecisionDenied
+			Expect(approval.IsApproved()).To(BeFalse())
+		})
+
+		It("IsByRequester() should compare the approver to the requester", func() {
+			request := &ExecAccessRequest{}
+			Expect(approval.IsByRequester(request)).To(BeFalse())
+
+			request.Spec.RequestedBy = &RequesterInfo{Username: "forged"}
+			Expect(approval.IsByRequester(request)).To(BeTrue())
+
+			request.Spec.RequestedBy.Username = requester
+			Expect(approval.IsByRequester(request)).To(BeFalse())
+		})
+
+		It("ApprovalConfig helpers should behave", func() {
+			cfg := &ApprovalConfig{ApproverGroups: []string{"admins", "leads"}}
+			Expect(cfg.GetRequiredApprovals()).To(Equal(1))
+			Expect(cfg.IsApprover([]string{"devs", "leads"})).To(BeTrue())
+			Expect(cfg.IsApprover([]string{"devs"})).To(BeFalse())
+			Expect(cfg.IsApprover(nil)).To(BeFalse())
+
+			cfg.RequiredApprovals = 3
+			Expect(cfg.GetRequiredApprovals()).To(Equal(3))
+		})
+	})
+})
@@ -0,0 +1,106 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApprovalDecision is the decision recorded by an approver in an
+// AccessApproval resource.
+//
+// +kubebuilder:validation:Enum=Approved;Denied
+type ApprovalDecision string
+
+const (
+	// ApprovalDecisionApproved counts towards the ApprovalConfig.RequiredApprovals
+	// of the target Access Request.
+	ApprovalDecisionApproved ApprovalDecision = "Approved"
+
+	// ApprovalDecisionDenied blocks the target Access Request from ever being
+	// granted.
+	ApprovalDecisionDenied ApprovalDecision = "Denied"
+)
+
+// AccessApprovalSpec defines the desired state of AccessApproval
+type AccessApprovalSpec struct {
+	// RequestName is the name of the Access Request (in the same namespace)
+	// that this approval applies to.
+	//
+	// +kubebuilder:validation:Required
+	RequestName string `json:"requestName"`
+
+	// RequestKind is the Kind of the Access Request (eg. "ExecAccessRequest"
+	// or "PodAccessRequest") that this approval applies to.
+	//
+	// +kubebuilder:validation:Required
+	RequestKind string `json:"requestKind"`
+
+	// Decision is either "Approved" or "Denied".
+	//
+	// +kubebuilder:default:=Approved
+	Decision ApprovalDecision `json:"decision,omitempty"`
+
+	// Reason is an optional free-form explanation of the decision.
+	Reason string `json:"reason,omitempty"`
+
+	// Approver is the username of the user who created this AccessApproval.
+	// This field is populated by the mutating webhook and cannot be set by the
+	// user.
+	Approver string `json:"approver,omitempty"`
+
+	// ApproverGroups are the groups that the Approver was a member of at the
+	// time that this AccessApproval was created. This field is populated by
+	// the mutating webhook and cannot be set by the user.
+	ApproverGroups []string `json:"approverGroups,omitempty"`
+}
+
+// AccessApprovalStatus defines the observed state of AccessApproval
+type AccessApprovalStatus struct{}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// AccessApproval is the Schema for the accessapprovals API
+//
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.requestKind",description="Access Request Kind"
+// +kubebuilder:printcolumn:name="Request",type="string",JSONPath=".spec.requestName",description="Access Request Name"
+// +kubebuilder:printcolumn:name="Decision",type="string",JSONPath=".spec.decision",description="Approval Decision"
+// +kubebuilder:printcolumn:name="Approver",type="string",JSONPath=".spec.approver",description="Approver"
+type AccessApproval struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessApprovalSpec   `json:"spec,omitempty"`
+	Status AccessApprovalStatus `json:"status,omitempty"`
+}
+
+// IsFor returns true if this AccessApproval targets the supplied Access
+// Request kind and name.
+func (a *AccessApproval) IsFor(kind string, name string) bool {
+	return a.Spec.RequestKind == kind && a.Spec.RequestName == name
+}
+
+// IsByRequester returns true if this AccessApproval was created by the user
+// that created the supplied Access Request. Requesters can never approve (or
+// deny) their own requests.
+func (a *AccessApproval) IsByRequester(req IRequestResource) bool {
+	requester := req.GetRequestedBy()
+	return requester != nil && requester.Username != "" && a.Spec.Approver == requester.Username
+}
+
+// IsApproved returns true if the Spec.decision is "Approved".
+func (a *AccessApproval) IsApproved() bool {
+	return a.Spec.Decision != ApprovalDecisionDenied
+}
+
+//+kubebuilder:object:root=true
+
+// AccessApprovalList contains a list of AccessApproval
+type AccessApprovalList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessApproval `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccessApproval{}, &AccessApprovalList{})
+}
@@ -0,0 +1,131 @@
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/diranged/oz/internal/webhook"
+)
+
+// log is for logging in this package.
+var accessapprovallog = logf.Log.WithName("accessapproval-resource")
+
+// SetupWebhookWithManager configures the webhook service in the Manager to
+// accept MutatingWebhookConfiguration and ValidatingWebhookConfiguration calls
+// from the Kubernetes API server.
+func (r *AccessApproval) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	if err := webhook.RegisterContextualDefaulter(r, mgr); err != nil {
+		panic(err)
+	}
+	if err := webhook.RegisterContextualValidator(r, mgr); err != nil {
+		panic(err)
+	}
+
+	// boilerplate
+	return ctrl.NewWebhookManagedBy(mgr, r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-crds-wizardofoz-co-v1alpha1-accessapproval,mutating=true,failurePolicy=fail,sideEffects=None,groups=crds.wizardofoz.co,resources=accessapprovals,verbs=create;update,versions=v1alpha1,name=maccessapproval.kb.io,admissionReviewVersions=v1
+
+var _ webhook.IContextuallyDefaultableObject = &AccessApproval{}
+
+// Default records the identity of the user creating the AccessApproval into
+// the Spec.approver and Spec.approverGroups fields. Any user-supplied values
+// are overwritten, so that the approval cannot be forged.
+func (r *AccessApproval) Default(_ context.Context, req admission.Request) error {
+	if req.Operation != admissionv1.Create {
+		return nil
+	}
+	r.Spec.Approver = req.UserInfo.Username
+	r.Spec.ApproverGroups = req.UserInfo.Groups
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-crds-wizardofoz-co-v1alpha1-accessapproval,mutating=false,failurePolicy=fail,sideEffects=None,groups=crds.wizardofoz.co,resources=accessapprovals,verbs=create;update;delete,versions=v1alpha1,name=vaccessapproval.kb.io,admissionReviewVersions=v1
+
+var _ webhook.IContextuallyValidatableObject = &AccessApproval{}
+
+// ValidateCreate rejects any AccessApproval that is created without a known
+// user identity - an approval is meaningless without knowing who made it. It
+// also rejects approvals created by the user that created the target Access
+// Request, who can never approve their own request.
+func (r *AccessApproval) ValidateCreate(ctx context.Context, req admission.Request) (admission.Warnings, error) {
+	if req.UserInfo.Username == "" {
+		return nil, errors.New("error - AccessApproval resources require a user identity")
+	}
+	accessapprovallog.Info(
+		fmt.Sprintf(
+			"Create AccessApproval (%s %s/%s) from %s",
+			r.Spec.Decision, r.Spec.RequestKind, r.Spec.RequestName, req.UserInfo.Username,
+		),
+	)
+
+	return nil, r.verifyNotRequester(ctx)
+}
+
+// verifyNotRequester looks up the Access Request that the AccessApproval
+// targets, and verifies that it was not created by the approver. Approvals
+// for requests that do not exist (yet) are allowed - the controller ignores
+// self-approvals either way.
+func (r *AccessApproval) verifyNotRequester(ctx context.Context) error {
+	cl, err := getWebhookClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	obj, err := cl.Scheme().New(GroupVersion.WithKind(r.Spec.RequestKind))
+	request, ok := obj.(IRequestResource)
+	if err != nil || !ok {
+		return fmt.Errorf("spec.requestKind %q is not a known Access Request kind", r.Spec.RequestKind)
+	}
+
+	err = cl.Get(ctx, types.NamespacedName{Name: r.Spec.RequestName, Namespace: r.GetNamespace()}, request)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to get %s %q: %w", r.Spec.RequestKind, r.Spec.RequestName, err)
+	}
+
+	if r.IsByRequester(request) {
+		return fmt.Errorf(
+			"user %q created %s %q, and cannot approve their own request",
+			r.Spec.Approver, r.Spec.RequestKind, r.Spec.RequestName,
+		)
+	}
+	return nil
+}
+
+// ValidateUpdate prevents any changes to the Spec of an AccessApproval.
+func (r *AccessApproval) ValidateUpdate(_ context.Context, _ admission.Request, old runtime.Object) (admission.Warnings, error) {
+	accessapprovallog.Info("validate update", "name", r.Name)
+
+	oldApproval, ok := old.(*AccessApproval)
+	if !ok {
+		return nil, fmt.Errorf("expected an AccessApproval, but got a %T", old)
+	}
+	if !equality.Semantic.DeepEqual(r.Spec, oldApproval.Spec) {
+		return nil, errors.New(
+			"error - AccessApproval.Spec is immutable, create a new AccessApproval instead",
+		)
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.IContextuallyValidatableObject so a webhook will be registered for the type
+func (r *AccessApproval) ValidateDelete(_ context.Context, req admission.Request) (admission.Warnings, error) {
+	accessapprovallog.Info(
+		fmt.Sprintf("Delete AccessApproval from %s", req.UserInfo.Username),
+	)
+	return nil, nil
+}
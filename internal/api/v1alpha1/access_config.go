@@ -1,9 +1,26 @@
 package v1alpha1
 
 import (
+	"fmt"
 	"time"
 )
 
+// BindToMode describes which subjects the RoleBinding created for an Access
+// Request grants access to.
+//
+// +kubebuilder:validation:Enum=requester;groups
+type BindToMode string
+
+const (
+	// BindToRequester binds the access resources only to the user that
+	// created the Access Request.
+	BindToRequester BindToMode = "requester"
+
+	// BindToGroups binds the access resources to every group listed in
+	// AccessConfig.AllowedGroups.
+	BindToGroups BindToMode = "groups"
+)
+
 // AccessConfig provides a common interface for our Template structs (which implement
 // ITemplateResource) for defining which entities are being granted access to a resource, and for
 // how long they are granted that access.
@@ -36,6 +53,49 @@ type AccessConfig struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default:="kubectl exec -ti -n {{ .Metadata.Namespace }} {{ .Metadata.Name }} -- /bin/sh"
 	AccessCommand string `json:"accessCommand"`
+
+	// BindTo controls who is granted access by the RoleBinding that is
+	// created for each Access Request. When set to "groups", every member of
+	// the AllowedGroups can use the access granted to any one of them. When
+	// set to "requester", only the user that created the Access Request is
+	// granted access.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="groups"
+	BindTo BindToMode `json:"bindTo,omitempty"`
+
+	// ApprovalConfig optionally requires that Access Requests be approved by
+	// one or more members of a set of approver groups (through AccessApproval
+	// resources) before any access is granted.
+	//
+	// +kubebuilder:validation:Optional
+	ApprovalConfig *ApprovalConfig `json:"approvalConfig,omitempty"`
+
+	// MaxConcurrentRequests limits the number of live Access Requests that
+	// can be made against this template at any one time, by all users
+	// together. Requests that are being deleted, have been revoked or have
+	// expired do not count. Zero (the default) means there is no limit.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrentRequests int `json:"maxConcurrentRequests,omitempty"`
+
+	// MaxConcurrentRequestsPerUser limits the number of live Access Requests
+	// that each user can make against this template at any one time. Users
+	// are identified by the Spec.requestedBy field recorded on their
+	// requests. Zero (the default) means there is no limit.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrentRequestsPerUser int `json:"maxConcurrentRequestsPerUser,omitempty"`
+
+	// AllowedWindows optionally limits the times at which Access Requests
+	// can be made against this template. Requests are only admitted while
+	// one of the windows is open, and the access granted to them ends when
+	// the windows close. If omitted, requests can be made at any time.
+	//
+	// +kubebuilder:validation:Optional
+	AllowedWindows []AccessWindow `json:"allowedWindows,omitempty"`
 }
 
 // GetAllowedGroups returns the Spec.AllowedGroups for this particular template
@@ -43,6 +103,33 @@ func (a *AccessConfig) GetAllowedGroups() []string {
 	return a.AllowedGroups
 }
 
+// IsAllowed returns true if any of the supplied groups is one of the
+// Spec.allowedGroups.
+func (a *AccessConfig) IsAllowed(groups []string) bool {
+	for _, allowedGroup := range a.AllowedGroups {
+		for _, group := range groups {
+			if group == allowedGroup {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetBindTo returns the Spec.bindTo mode, defaulting to BindToGroups.
+func (a *AccessConfig) GetBindTo() BindToMode {
+	if a.BindTo == "" {
+		return BindToGroups
+	}
+	return a.BindTo
+}
+
+// GetApprovalConfig returns the Spec.approvalConfig, or nil if no approvals
+// are required.
+func (a *AccessConfig) GetApprovalConfig() *ApprovalConfig {
+	return a.ApprovalConfig
+}
+
 // GetDefaultDuration parses the Spec.defaultDuration field into a time.Duration struct.
 //
 // Returns:
@@ -62,3 +149,55 @@ func (a *AccessConfig) GetDefaultDuration() (time.Duration, error) {
 func (a *AccessConfig) GetMaxDuration() (time.Duration, error) {
 	return time.ParseDuration(a.MaxDuration)
 }
+
+// ValidateDurations verifies that Spec.accessConfig.defaultDuration and
+// Spec.accessConfig.maxDuration are valid, positive durations, and that the
+// default is not greater than the maximum.
+func (a *AccessConfig) ValidateDurations() error {
+	defaultDuration, err := a.GetDefaultDuration()
+	if err != nil {
+		return fmt.Errorf("invalid spec.accessConfig.defaultDuration: %w", err)
+	}
+	maxDuration, err := a.GetMaxDuration()
+	if err != nil {
+		return fmt.Errorf("invalid spec.accessConfig.maxDuration: %w", err)
+	}
+	if defaultDuration <= 0 || maxDuration <= 0 {
+		return fmt.Errorf(
+			"spec.accessConfig.defaultDuration (%s) and spec.accessConfig.maxDuration (%s) must be positive",
+			a.DefaultDuration, a.MaxDuration,
+		)
+	}
+	if defaultDuration > maxDuration {
+		return fmt.Errorf(
+			"spec.accessConfig.defaultDuration (%s) can not be greater than spec.accessConfig.maxDuration (%s)",
+			a.DefaultDuration, a.MaxDuration,
+		)
+	}
+	return nil
+}
+
+// HasConcurrencyLimits returns true if either Spec.accessConfig.maxConcurrentRequests
+// or Spec.accessConfig.maxConcurrentRequestsPerUser is set.
+func (a *AccessConfig) HasConcurrencyLimits() bool {
+	return a.MaxConcurrentRequests > 0 || a.MaxConcurrentRequestsPerUser > 0
+}
+
+// ValidateConcurrencyLimits verifies that Spec.accessConfig.maxConcurrentRequests
+// and Spec.accessConfig.maxConcurrentRequestsPerUser are not negative, and
+// that the per-user limit is not greater than the overall limit.
+func (a *AccessConfig) ValidateConcurrencyLimits() error {
+	if a.MaxConcurrentRequests < 0 || a.MaxConcurrentRequestsPerUser < 0 {
+		return fmt.Errorf(
+			"spec.accessConfig.maxConcurrentRequests (%d) and spec.accessConfig.maxConcurrentRequestsPerUser (%d) can not be negative",
+			a.MaxConcurrentRequests, a.MaxConcurrentRequestsPerUser,
+		)
+	}
+	if a.MaxConcurrentRequests > 0 && a.MaxConcurrentRequestsPerUser > a.MaxConcurrentRequests {
+		return fmt.Errorf(
+			"spec.accessConfig.maxConcurrentRequestsPerUser (%d) can not be greater than spec.accessConfig.maxConcurrentRequests (%d)",
+			a.MaxConcurrentRequestsPerUser, a.MaxConcurrentRequests,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// AccessRuleResource is a Pod resource (or subresource) that an AccessRule
+// can grant permissions on.
+//
+// +kubebuilder:validation:Enum=pods;pods/exec;pods/portforward;pods/log;pods/attach;pods/ephemeralcontainers
+type AccessRuleResource string
+
+// validAccessRuleResources is the list of resources that an AccessRule may
+// reference. This must be kept in sync with the Enum above.
+var validAccessRuleResources = []AccessRuleResource{
+	"pods",
+	"pods/exec",
+	"pods/portforward",
+	"pods/log",
+	"pods/attach",
+	"pods/ephemeralcontainers",
+}
+
+// AccessRule describes a set of permissions that are granted on the target
+// Pod of an Access Request. Rules are always scoped to the target Pod - the
+// name of the Pod is filled in as the only ResourceName of the generated RBAC
+// PolicyRule when the access resources are created, so a rule can never grant
+// access to any other resource.
+type AccessRule struct {
+	// Resources lists the Pod resources (and subresources) that this rule
+	// grants access to.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Resources []AccessRuleResource `json:"resources"`
+
+	// Verbs lists the verbs (eg. "get", "create") that are granted on the
+	// Resources. Wildcards are not allowed.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Verbs []string `json:"verbs"`
+}
+
+// Validate verifies that the AccessRule only references Pod resources, and
+// does not use wildcard verbs.
+func (r AccessRule) Validate() error {
+	if len(r.Resources) == 0 {
+		return errors.New("accessRules must list at least one resource")
+	}
+	if len(r.Verbs) == 0 {
+		return errors.New("accessRules must list at least one verb")
+	}
+
+	for _, resource := range r.Resources {
+		if !slices.Contains(validAccessRuleResources, resource) {
+			return fmt.Errorf("accessRules resource %q is not allowed", resource)
+		}
+	}
+
+	for _, verb := range r.Verbs {
+		if verb == "" || verb == "*" {
+			return fmt.Errorf("accessRules verb %q is not allowed", verb)
+		}
+	}
+	return nil
+}
+
+// DefaultAccessRules returns the rules that are used when a template does not
+// define its own AccessRules. These grant read access to the target Pod, and
+// the ability to "kubectl exec" into it.
+func DefaultAccessRules() []AccessRule {
+	return []AccessRule{
+		{
+			Resources: []AccessRuleResource{"pods"},
+			Verbs:     []string{"get", "list", "watch"},
+		},
+		{
+			Resources: []AccessRuleResource{"pods/exec"},
+			Verbs:     []string{"create", "update", "delete", "get", "list"},
+		},
+	}
+}
@@ -0,0 +1,214 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Weekday is a day of the week, in its three letter English form.
+//
+// +kubebuilder:validation:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+type Weekday string
+
+// weekdays maps each Weekday onto the matching time.Weekday.
+var weekdays = map[Weekday]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// AccessWindow describes a recurring period of time, on certain days of the
+// week, during which Access Requests may be made against a template. For
+// example, business hours in New York:
+//
+//	days: [Mon, Tue, Wed, Thu, Fri]
+//	start: "09:00"
+//	end: "17:00"
+//	timeZone: America/New_York
+type AccessWindow struct {
+	// Days lists the days of the week on which the window opens. If omitted,
+	// the window opens every day.
+	//
+	// +kubebuilder:validation:Optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start is the time of day at which the window opens, in 24-hour "HH:MM"
+	// format.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End is the time of day at which the window closes, in 24-hour "HH:MM"
+	// format. If End is not after Start, the window closes on the following
+	// day - eg. "22:00" to "06:00" covers the night.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+
+	// TimeZone is the IANA name of the time zone (eg. "Europe/London") that
+	// Start and End are in.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="UTC"
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// String returns a short description of the window, eg.
+// "Mon,Tue 09:00-17:00 America/New_York".
+func (w *AccessWindow) String() string {
+	days := "every day"
+	if len(w.Days) > 0 {
+		names := []string{}
+		for _, day := range w.Days {
+			names = append(names, string(day))
+		}
+		days = strings.Join(names, ",")
+	}
+	return fmt.Sprintf("%s %s-%s %s", days, w.Start, w.End, w.getTimeZone())
+}
+
+// Validate verifies that the days, times and time zone of the window can be
+// parsed.
+func (w *AccessWindow) Validate() error {
+	_, _, err := w.parse()
+	return err
+}
+
+// closesAt returns the time at which the window closes, if it is open at t.
+func (w *AccessWindow) closesAt(t time.Time) (closes time.Time, open bool, err error) {
+	start, end, err := w.parse()
+	if err != nil {
+		return closes, false, err
+	}
+	t = t.In(start.Location())
+
+	// A window that closes on the following day may have opened yesterday.
+	for _, offset := range []int{0, -1} {
+		day := time.Date(t.Year(), t.Month(), t.Day()+offset, 0, 0, 0, 0, t.Location())
+		if !w.isOpenOn(day.Weekday()) {
+			continue
+		}
+		opens := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, day.Location())
+		closes = time.Date(day.Year(), day.Month(), day.Day(), end.Hour(), end.Minute(), 0, 0, day.Location())
+		if !closes.After(opens) {
+			closes = time.Date(day.Year(), day.Month(), day.Day()+1, end.Hour(), end.Minute(), 0, 0, day.Location())
+		}
+		if !t.Before(opens) && t.Before(closes) {
+			return closes, true, nil
+		}
+	}
+	return time.Time{}, false, nil
+}
+
+// isOpenOn returns true if the window opens on the supplied day of the week.
+func (w *AccessWindow) isOpenOn(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, d := range w.Days {
+		if weekdays[d] == day {
+			return true
+		}
+	}
+	return false
+}
+
+// parse returns the Start and End of the window as times of day (on the zero
+// date) in the time zone of the window.
+func (w *AccessWindow) parse() (start time.Time, end time.Time, err error) {
+	for _, day := range w.Days {
+		if _, ok := weekdays[day]; !ok {
+			return start, end, fmt.Errorf("invalid day %q, must be one of Mon, Tue, Wed, Thu, Fri, Sat or Sun", day)
+		}
+	}
+	loc, err := time.LoadLocation(w.getTimeZone())
+	if err != nil {
+		return start, end, fmt.Errorf("invalid timeZone: %w", err)
+	}
+	if start, err = time.ParseInLocation("15:04", w.Start, loc); err != nil {
+		return start, end, fmt.Errorf("invalid start %q, must be in HH:MM format", w.Start)
+	}
+	if end, err = time.ParseInLocation("15:04", w.End, loc); err != nil {
+		return start, end, fmt.Errorf("invalid end %q, must be in HH:MM format", w.End)
+	}
+	return start, end, nil
+}
+
+// getTimeZone returns the TimeZone of the window, defaulting to UTC.
+func (w *AccessWindow) getTimeZone() string {
+	if w.TimeZone == "" {
+		return "UTC"
+	}
+	return w.TimeZone
+}
+
+// HasAllowedWindows returns true if Spec.accessConfig.allowedWindows is set,
+// and Access Requests are limited to those windows.
+func (a *AccessConfig) HasAllowedWindows() bool {
+	return len(a.AllowedWindows) > 0
+}
+
+// DescribeAllowedWindows returns a short description of all of the
+// Spec.accessConfig.allowedWindows, eg. "Mon,Tue 09:00-17:00 UTC; Sat 10:00-12:00 UTC".
+func (a *AccessConfig) DescribeAllowedWindows() string {
+	windows := []string{}
+	for i := range a.AllowedWindows {
+		windows = append(windows, a.AllowedWindows[i].String())
+	}
+	return strings.Join(windows, "; ")
+}
+
+// ValidateAllowedWindows verifies that every one of the
+// Spec.accessConfig.allowedWindows can be parsed.
+func (a *AccessConfig) ValidateAllowedWindows() error {
+	for i := range a.AllowedWindows {
+		if err := a.AllowedWindows[i].Validate(); err != nil {
+			return fmt.Errorf("invalid spec.accessConfig.allowedWindows[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// GetWindowClose returns the time at which access that starts at t has to
+// end, because the Spec.accessConfig.allowedWindows close. Windows that open
+// before (or right as) another one closes extend it. If the template has no
+// allowedWindows, open is true and closes is the zero time. If t is outside
+// of all of the windows, open is false.
+func (a *AccessConfig) GetWindowClose(t time.Time) (closes time.Time, open bool, err error) {
+	if !a.HasAllowedWindows() {
+		return closes, true, nil
+	}
+
+	// Each pass moves the close time on to the end of a later window - a
+	// window can only open once a day, so a week's worth of passes (per
+	// window) covers every way in which they can overlap. Windows that
+	// never close are capped there.
+	for pass := 0; pass <= 7*len(a.AllowedWindows); pass++ {
+		at := t
+		if open {
+			at = closes
+		}
+		latest, found := time.Time{}, false
+		for i := range a.AllowedWindows {
+			windowCloses, windowOpen, err := a.AllowedWindows[i].closesAt(at)
+			if err != nil {
+				return time.Time{}, false, fmt.Errorf("invalid spec.accessConfig.allowedWindows[%d]: %w", i, err)
+			}
+			if windowOpen && windowCloses.After(latest) {
+				latest, found = windowCloses, true
+			}
+		}
+		if !found {
+			break
+		}
+		closes, open = latest, true
+	}
+	return closes, open, nil
+}
@@ -0,0 +1,125 @@
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AccessWindow", func() {
+	newYork, _ := time.LoadLocation("America/New_York")
+
+	// 2022-12-05 was a Monday
+	monday := func(hour, minute int, loc *time.Location) time.Time {
+		return time.Date(2022, 12, 5, hour, minute, 0, 0, loc)
+	}
+
+	businessHours := AccessWindow{
+		Days:     []Weekday{"Mon", "Tue", "Wed", "Thu", "Fri"},
+		Start:    "09:00",
+		End:      "17:00",
+		TimeZone: "America/New_York",
+	}
+
+	It("String() should describe the window", func() {
+		Expect(businessHours.String()).To(Equal("Mon,Tue,Wed,Thu,Fri 09:00-17:00 America/New_York"))
+		Expect((&AccessWindow{Start: "22:00", End: "06:00"}).String()).To(Equal("every day 22:00-06:00 UTC"))
+	})
+
+	It("Validate() should reject windows that can not be parsed", func() {
+		Expect(businessHours.Validate()).To(Succeed())
+		Expect((&AccessWindow{Days: []Weekday{"Monday"}, Start: "09:00", End: "17:00"}).Validate()).
+			To(MatchError(ContainSubstring("invalid day \"Monday\"")))
+		Expect((&AccessWindow{Start: "9am", End: "17:00"}).Validate()).
+			To(MatchError(ContainSubstring("invalid start \"9am\"")))
+		Expect((&AccessWindow{Start: "09:00", End: "24:00"}).Validate()).
+			To(MatchError(ContainSubstring("invalid end \"24:00\"")))
+		Expect((&AccessWindow{Start: "09:00", End: "17:00", TimeZone: "Mars/Olympus_Mons"}).Validate()).
+			To(MatchError(ContainSubstring("invalid timeZone")))
+	})
+
+	It("GetWindowClose() should allow any time without windows", func() {
+		config := &AccessConfig{}
+		closes, open, err := config.GetWindowClose(monday(3, 0, time.UTC))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(open).To(BeTrue())
+		Expect(closes.IsZero()).To(BeTrue())
+	})
+
+	It("GetWindowClose() should honor the days, times and time zone of the window", func() {
+		config := &AccessConfig{AllowedWindows: []AccessWindow{businessHours}}
+
+		// VERIFY: Monday morning in New York is open until the evening
+		closes, open, err := config.GetWindowClose(monday(10, 30, newYork))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(open).To(BeTrue())
+		Expect(closes).To(BeTemporally("==", monday(17, 0, newYork)))
+
+		// VERIFY: The same time in UTC is before the window opens
+		_, open, err = config.GetWindowClose(monday(10, 30, time.UTC))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(open).To(BeFalse())
+
+		// VERIFY: The window closes right at the end
+		_, open, _ = config.GetWindowClose(monday(17, 0, newYork))
+		Expect(open).To(BeFalse())
+
+		// VERIFY: Sunday is closed all day
+		_, open, _ = config.GetWindowClose(monday(12, 0, newYork).AddDate(0, 0, -1))
+		Expect(open).To(BeFalse())
+	})
+
+	It("GetWindowClose() should handle windows that close the next day", func() {
+		config := &AccessConfig{AllowedWindows: []AccessWindow{
+			{Days: []Weekday{"Mon"}, Start: "22:00", End: "06:00"},
+		}}
+
+		closes, open, err := config.GetWindowClose(monday(23, 0, time.UTC))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(open).To(BeTrue())
+		Expect(closes).To(BeTemporally("==", monday(6, 0, time.UTC).AddDate(0, 0, 1)))
+
+		// VERIFY: Tuesday morning is still in Monday's window
+		closes, open, _ = config.GetWindowClose(monday(5, 0, time.UTC).AddDate(0, 0, 1))
+		Expect(open).To(BeTrue())
+		Expect(closes).To(BeTemporally("==", monday(6, 0, time.UTC).AddDate(0, 0, 1)))
+
+		// VERIFY: But Monday morning is not
+		_, open, _ = config.GetWindowClose(monday(5, 0, time.UTC))
+		Expect(open).To(BeFalse())
+	})
+
+	It("GetWindowClose() should join windows that meet or overlap", func() {
+		config := &AccessConfig{AllowedWindows: []AccessWindow{
+			{Start: "09:00", End: "17:00"},
+			{Start: "17:00", End: "20:00"},
+			{Start: "19:00", End: "21:00"},
+		}}
+
+		closes, open, err := config.GetWindowClose(monday(10, 0, time.UTC))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(open).To(BeTrue())
+		Expect(closes).To(BeTemporally("==", monday(21, 0, time.UTC)))
+	})
+
+	It("GetWindowClose() should cap windows that never close", func() {
+		config := &AccessConfig{AllowedWindows: []AccessWindow{{Start: "00:00", End: "00:00"}}}
+
+		closes, open, err := config.GetWindowClose(monday(10, 0, time.UTC))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(open).To(BeTrue())
+		Expect(closes).To(BeTemporally(">", monday(10, 0, time.UTC).AddDate(0, 0, 7)))
+	})
+
+	It("ValidateAllowedWindows() should point at the invalid window", func() {
+		config := &AccessConfig{AllowedWindows: []AccessWindow{
+			businessHours,
+			{Start: "09:00", End: "17:00", TimeZone: "Nowhere"},
+		}}
+		Expect(config.ValidateAllowedWindows()).To(MatchError(ContainSubstring("allowedWindows[1]")))
+		Expect(config.DescribeAllowedWindows()).To(Equal(
+			"Mon,Tue,Wed,Thu,Fri 09:00-17:00 America/New_York; every day 09:00-17:00 Nowhere",
+		))
+	})
+})
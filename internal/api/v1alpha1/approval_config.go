@@ -0,0 +1,43 @@
+package v1alpha1
+
+// ApprovalConfig describes an optional approval workflow for Access Requests
+// that reference a template. When set, the RequestReconciler will not create
+// any access resources until enough AccessApproval resources have been created
+// by members of the ApproverGroups.
+type ApprovalConfig struct {
+	// ApproverGroups lists out the groups (in string name form) whose members
+	// are allowed to approve (or deny) Access Requests against this template.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	ApproverGroups []string `json:"approverGroups"`
+
+	// RequiredApprovals is the number of unique approvers that must approve
+	// an Access Request before access is granted.
+	//
+	// +kubebuilder:default:=1
+	// +kubebuilder:validation:Minimum=1
+	RequiredApprovals int `json:"requiredApprovals,omitempty"`
+}
+
+// GetRequiredApprovals returns the number of approvals required, never
+// returning less than one.
+func (c *ApprovalConfig) GetRequiredApprovals() int {
+	if c.RequiredApprovals < 1 {
+		return 1
+	}
+	return c.RequiredApprovals
+}
+
+// IsApprover returns true if any of the supplied groups is one of the
+// Spec.approverGroups.
+func (c *ApprovalConfig) IsApprover(groups []string) bool {
+	for _, approverGroup := range c.ApproverGroups {
+		for _, group := range groups {
+			if group == approverGroup {
+				return true
+			}
+		}
+	}
+	return false
+}
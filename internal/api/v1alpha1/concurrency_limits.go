@@ -0,0 +1,133 @@
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// IsRequestLive returns true if the Access Request is live - it is not being
+// deleted, has neither been revoked nor expired, and its access has started.
+// Only live requests count against the concurrency limits of their template.
+//
+// Requests that are scheduled to start later (Spec.startTime) are not live
+// until their start time; their concurrency limits are checked when they
+// start.
+func IsRequestLive(req IRequestResource, now time.Time) bool {
+	if req.GetDeletionTimestamp() != nil || req.IsRevoked() {
+		return false
+	}
+	if req.GetStartTime().After(now) {
+		return false
+	}
+	if reqStatus, ok := req.GetStatus().(IRequestStatus); ok {
+		if expiresAt := reqStatus.GetExpiresAt(); expiresAt != nil && !now.Before(expiresAt.Time) {
+			return false
+		}
+	}
+	return true
+}
+
+// ListLiveRequests returns the live Access Requests in the namespace that are
+// of the same kind as obj, and reference the named template.
+func ListLiveRequests(
+	ctx context.Context,
+	cl client.Client,
+	obj IRequestResource,
+	namespace string,
+	templateName string,
+	now time.Time,
+) ([]IRequestResource, error) {
+	gvk, err := apiutil.GVKForObject(obj, cl.Scheme())
+	if err != nil {
+		return nil, err
+	}
+	newList, err := cl.Scheme().New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err != nil {
+		return nil, err
+	}
+	list, ok := newList.(client.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("%sList is not a list", gvk.Kind)
+	}
+
+	if err := cl.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("unable to list %ss: %w", gvk.Kind, err)
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := []IRequestResource{}
+	for _, item := range items {
+		req, ok := item.(IRequestResource)
+		if !ok || req.GetTemplateName() != templateName || !IsRequestLive(req, now) {
+			continue
+		}
+		requests = append(requests, req)
+	}
+	return requests, nil
+}
+
+// VerifyConcurrencyLimits verifies that one more Access Request by the user
+// stays within the Spec.accessConfig.maxConcurrentRequests and
+// Spec.accessConfig.maxConcurrentRequestsPerUser limits of the template. The
+// live argument holds the live requests that already count against the
+// template. If the username is not known, only the overall limit applies.
+//
+// The returned error lists the live requests of the user, so that they can
+// reuse or revoke one of them instead.
+func (a *AccessConfig) VerifyConcurrencyLimits(
+	templateName string,
+	username string,
+	live []IRequestResource,
+) error {
+	mine := []IRequestResource{}
+	for _, req := range live {
+		if requester := req.GetRequestedBy(); username != "" && requester != nil && requester.Username == username {
+			mine = append(mine, req)
+		}
+	}
+
+	if a.MaxConcurrentRequestsPerUser > 0 && username != "" && len(mine) >= a.MaxConcurrentRequestsPerUser {
+		return fmt.Errorf(
+			"user %q already has %d live Access Requests against Access Template %q (maxConcurrentRequestsPerUser: %d) - reuse or revoke one of them: %s",
+			username, len(mine), templateName, a.MaxConcurrentRequestsPerUser, describeRequests(mine),
+		)
+	}
+
+	if a.MaxConcurrentRequests > 0 && len(live) >= a.MaxConcurrentRequests {
+		msg := fmt.Sprintf(
+			"there are already %d live Access Requests against Access Template %q (maxConcurrentRequests: %d)",
+			len(live), templateName, a.MaxConcurrentRequests,
+		)
+		if len(mine) > 0 {
+			msg = fmt.Sprintf("%s - reuse or revoke one of your own: %s", msg, describeRequests(mine))
+		}
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// describeRequests returns a list of the names of the requests, along with
+// when they expire, eg. "foo (expires 2022-12-01T10:00:00Z), bar".
+func describeRequests(reqs []IRequestResource) string {
+	names := []string{}
+	for _, req := range reqs {
+		name := req.GetName()
+		if reqStatus, ok := req.GetStatus().(IRequestStatus); ok && reqStatus.GetExpiresAt() != nil {
+			name = fmt.Sprintf("%s (expires %s)", name, reqStatus.GetExpiresAt().UTC().Format(time.RFC3339))
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
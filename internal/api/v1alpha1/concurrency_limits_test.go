@@ -0,0 +1,103 @@
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ConcurrencyLimits", func() {
+	now := time.Date(2022, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	newRequest := func(name, username string) *ExecAccessRequest {
+		return &ExecAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: ExecAccessRequestSpec{
+				TemplateName: "test",
+				RequestedBy:  &RequesterInfo{Username: username},
+			},
+		}
+	}
+
+	It("IsRequestLive() should ignore deleted, revoked and expired requests", func() {
+		req := newRequest("live", "alice")
+		Expect(IsRequestLive(req, now)).To(BeTrue())
+
+		req.Status.SetExpiresAt(metav1.NewTime(now.Add(time.Minute)))
+		Expect(IsRequestLive(req, now)).To(BeTrue())
+		Expect(IsRequestLive(req, now.Add(time.Minute))).To(BeFalse())
+
+		req = newRequest("revoked", "alice")
+		req.Spec.Revoked = true
+		Expect(IsRequestLive(req, now)).To(BeFalse())
+
+		req = newRequest("deleted", "alice")
+		req.DeletionTimestamp = &metav1.Time{Time: now}
+		Expect(IsRequestLive(req, now)).To(BeFalse())
+	})
+
+	It("IsRequestLive() should ignore requests that have not started yet", func() {
+		req := newRequest("scheduled", "alice")
+		req.CreationTimestamp = metav1.NewTime(now.Add(-time.Hour))
+		req.Spec.StartTime = &metav1.Time{Time: now.Add(time.Hour)}
+		Expect(IsRequestLive(req, now)).To(BeFalse())
+		Expect(IsRequestLive(req, now.Add(time.Hour))).To(BeTrue())
+	})
+
+	It("VerifyConcurrencyLimits() should do nothing without limits", func() {
+		config := &AccessConfig{}
+		Expect(config.HasConcurrencyLimits()).To(BeFalse())
+		Expect(config.VerifyConcurrencyLimits("test", "alice", []IRequestResource{
+			newRequest("a", "alice"), newRequest("b", "alice"),
+		})).To(Succeed())
+	})
+
+	It("VerifyConcurrencyLimits() should enforce maxConcurrentRequestsPerUser", func() {
+		config := &AccessConfig{MaxConcurrentRequestsPerUser: 2}
+		mine := newRequest("alice-1", "alice")
+		mine.Status.SetExpiresAt(metav1.NewTime(now))
+		live := []IRequestResource{mine, newRequest("bob-1", "bob")}
+
+		// VERIFY: Alice has room for one more request
+		Expect(config.VerifyConcurrencyLimits("test", "alice", live)).To(Succeed())
+
+		// VERIFY: But not two, and the error lists her requests
+		live = append(live, newRequest("alice-2", "alice"))
+		Expect(config.VerifyConcurrencyLimits("test", "alice", live)).To(MatchError(
+			`user "alice" already has 2 live Access Requests against Access Template "test" ` +
+				`(maxConcurrentRequestsPerUser: 2) - reuse or revoke one of them: ` +
+				`alice-1 (expires 2022-12-01T10:00:00Z), alice-2`,
+		))
+
+		// VERIFY: Bob is not affected, nor are unknown users
+		Expect(config.VerifyConcurrencyLimits("test", "bob", live)).To(Succeed())
+		Expect(config.VerifyConcurrencyLimits("test", "", live)).To(Succeed())
+	})
+
+	It("VerifyConcurrencyLimits() should enforce maxConcurrentRequests", func() {
+		config := &AccessConfig{MaxConcurrentRequests: 2}
+		live := []IRequestResource{newRequest("alice-1", "alice"), newRequest("bob-1", "bob")}
+
+		Expect(config.VerifyConcurrencyLimits("test", "carol", live)).To(MatchError(
+			ContainSubstring("there are already 2 live Access Requests against Access Template \"test\""),
+		))
+		Expect(config.VerifyConcurrencyLimits("test", "alice", live)).To(MatchError(
+			ContainSubstring("reuse or revoke one of your own: alice-1"),
+		))
+		Expect(config.VerifyConcurrencyLimits("test", "alice", live[:1])).To(Succeed())
+	})
+
+	It("ValidateConcurrencyLimits() should reject nonsensical limits", func() {
+		Expect((&AccessConfig{MaxConcurrentRequests: 5, MaxConcurrentRequestsPerUser: 1}).
+			ValidateConcurrencyLimits()).To(Succeed())
+		Expect((&AccessConfig{MaxConcurrentRequestsPerUser: 1}).
+			ValidateConcurrencyLimits()).To(Succeed())
+		Expect((&AccessConfig{MaxConcurrentRequests: -1}).
+			ValidateConcurrencyLimits()).To(MatchError(ContainSubstring("can not be negative")))
+		Expect((&AccessConfig{MaxConcurrentRequests: 1, MaxConcurrentRequestsPerUser: 2}).
+			ValidateConcurrencyLimits()).To(MatchError(ContainSubstring("can not be greater than")))
+	})
+})
@@ -38,6 +38,27 @@ const (
 
 	// ConditionAccessMessage is used to record
 	ConditionAccessMessage RequestConditionTypes = "AccessMessage"
+
+	// ConditionApprovalGranted indicates whether or not the Access Request
+	// has received enough AccessApprovals to be granted. This condition is
+	// only set when the template has an ApprovalConfig.
+	ConditionApprovalGranted RequestConditionTypes = "ApprovalGranted"
+
+	// ConditionWithinConcurrencyLimits indicates whether or not the Access
+	// Request fits within the MaxConcurrentRequests and
+	// MaxConcurrentRequestsPerUser limits of its template. This condition is
+	// only set when the template has concurrency limits.
+	ConditionWithinConcurrencyLimits RequestConditionTypes = "WithinConcurrencyLimits"
+
+	// ConditionAccessStarted indicates whether or not the Spec.startTime of
+	// the Access Request has been reached. Until it has, the request is
+	// Scheduled and no access resources are created. This condition is only
+	// set when the request has a Spec.startTime.
+	ConditionAccessStarted RequestConditionTypes = "AccessStarted"
+
+	// ConditionUsageRecorded indicates whether or not the Access Request has
+	// been counted in the Status.usageCount of its template.
+	ConditionUsageRecorded RequestConditionTypes = "UsageRecorded"
 )
 
 // String implements the fmt.Stringer interface.
@@ -13,6 +13,9 @@ const (
 
 	// StatefulSetController maps to APIVersion: apps/v1, Kind: StatfulSet
 	StatefulSetController ControllerKind = "StatefulSet"
+
+	// RolloutController maps to APIVersion: argoproj.io/v1alpha1, Kind: Rollout
+	RolloutController ControllerKind = "Rollout"
 )
 
 const (
@@ -26,3 +29,13 @@ const (
 	// the fields we want to index.
 	FieldSelectorStatusPhase string = "status.phase"
 )
+
+const (
+	// LabelTemplateName is set on the Pods that are launched from a
+	// PodAccessTemplate.spec.podSpec, and holds the name of the template.
+	LabelTemplateName string = "crds.wizardofoz.co/template"
+
+	// LabelRequestName is set on the Pods that are launched from a
+	// PodAccessTemplate.spec.podSpec, and holds the name of the request.
+	LabelRequestName string = "crds.wizardofoz.co/request"
+)
@@ -19,6 +19,10 @@ type CoreStatus struct {
 	//   "Access Granted, connect to your pod with: kubectl exec -ti -n namespace pod-xyz -- /bin/bash"
 	//
 	AccessMessage string `json:"accessMessage,omitempty"`
+
+	// ExpiresAt is the time at which the access granted by an Access Request
+	// expires, and the request is deleted.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 }
 
 // https://stackoverflow.com/questions/33089523/how-to-mark-golang-struct-as-implementing-interface
@@ -52,6 +56,16 @@ func (in *CoreStatus) GetAccessMessage() string {
 	return in.AccessMessage
 }
 
+// SetExpiresAt sets (or updates) the Status.ExpiresAt field.
+func (in *CoreStatus) SetExpiresAt(t metav1.Time) {
+	in.ExpiresAt = &t
+}
+
+// GetExpiresAt returns the Status.ExpiresAt field, or nil if it is not set.
+func (in *CoreStatus) GetExpiresAt() *metav1.Time {
+	return in.ExpiresAt
+}
+
 // DeepCopyInto is typically auto-generated by controller-gen. However, it seems that controller-gen
 // fails when we include the ozResourceCoreStatus.Conditions field. Implementing our own DeepCopyInto function
 // resolves this, but does put the responsibility on us to keep this updated.
@@ -64,4 +78,8 @@ func (in *CoreStatus) DeepCopyInto(out *CoreStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 }
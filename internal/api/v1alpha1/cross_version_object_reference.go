@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -31,6 +32,46 @@ type CrossVersionObjectReference struct {
 	Name string `json:"name"`
 }
 
+// supportedControllers maps each controller kind that Oz can build Pods from
+// to the APIVersion it must be referenced with.
+var supportedControllers = map[ControllerKind]string{
+	DeploymentController:  "apps/v1",
+	DaemonSetController:   "apps/v1",
+	StatefulSetController: "apps/v1",
+	RolloutController:     "argoproj.io/v1alpha1",
+}
+
+// Validate verifies that the reference points to a kind of controller that Oz
+// understands, through a well-formed APIVersion.
+func (r *CrossVersionObjectReference) Validate() error {
+	if r.Name == "" {
+		return errors.New("spec.controllerTargetRef.name must be set")
+	}
+
+	gv, err := schema.ParseGroupVersion(r.APIVersion)
+	if err != nil || gv.Group == "" || gv.Version == "" {
+		return fmt.Errorf(
+			"spec.controllerTargetRef.apiVersion %q must be in the form group/version, eg. apps/v1",
+			r.APIVersion,
+		)
+	}
+
+	apiVersion, ok := supportedControllers[r.Kind]
+	if !ok {
+		return fmt.Errorf(
+			"spec.controllerTargetRef.kind %q is not supported, must be one of Deployment, DaemonSet, StatefulSet or Rollout",
+			r.Kind,
+		)
+	}
+	if r.APIVersion != apiVersion {
+		return fmt.Errorf(
+			"spec.controllerTargetRef.apiVersion of a %s must be %s, not %s",
+			r.Kind, apiVersion, r.APIVersion,
+		)
+	}
+	return nil
+}
+
 // String implements the Stringer interface
 func (r *CrossVersionObjectReference) String() string {
 	return fmt.Sprintf("%s %s",
@@ -44,9 +85,11 @@ func (r *CrossVersionObjectReference) GetGroup() string {
 	return strings.Split(r.APIVersion, "/")[0]
 }
 
-// GetVersion returns the API "Version" only (eg "v1")
+// GetVersion returns the API "Version" only (eg "v1"), or an empty string if
+// the APIVersion is malformed.
 func (r *CrossVersionObjectReference) GetVersion() string {
-	return strings.Split(r.APIVersion, "/")[1]
+	_, version, _ := strings.Cut(r.APIVersion, "/")
+	return version
 }
 
 // GetKind returns the resource Kind (eg "Deployment")
@@ -0,0 +1,145 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/diranged/oz/internal/testing/utils"
+)
+
+var _ = Describe("EphemeralContainerAccessRequest", Ordered, func() {
+	var (
+		namespace *corev1.Namespace
+		template  *EphemeralContainerAccessTemplate
+		request   *EphemeralContainerAccessRequest
+	)
+
+	// This Context() tests specific functions - no real calls against the API
+	// are made here, other than the lookup of the EphemeralContainerAccessTemplate.
+	Context("Functional Unit Tests", func() {
+		BeforeEach(func() {
+			request = &EphemeralContainerAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: namespace.Name,
+				},
+				Spec: EphemeralContainerAccessRequestSpec{
+					TemplateName: template.Name,
+				},
+			}
+		})
+
+		It("Default() should record the requester on create...", func() {
+			err := request.Default(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						Groups:   []string{"admins"},
+					},
+				},
+			})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(request.GetRequestedBy().Username).To(Equal("admin"))
+		})
+
+		It("ValidateCreate() should allow members of the allowedGroups...", func() {
+			_, err := request.ValidateCreate(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						Groups:   []string{"admins"},
+					},
+				},
+			})
+			Expect(err).To(Not(HaveOccurred()))
+		})
+
+		It("ValidateCreate() should reject users outside of the allowedGroups...", func() {
+			_, err := request.ValidateCreate(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						Groups:   []string{"others"},
+					},
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not a member of any of the allowedGroups"))
+		})
+
+		It("ValidateUpdate() should reject changes to Spec.RequestedBy...", func() {
+			oldReq := request.DeepCopy()
+			oldReq.Spec.RequestedBy = &RequesterInfo{Username: "admin"}
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.RequestedBy.Username = "other"
+			_, err := newReq.ValidateUpdate(webhookCtx, admission.Request{}, oldReq)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("ValidateUpdate() should reject changes to Spec.TargetPod...", func() {
+			oldReq := request.DeepCopy()
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.TargetPod = "other"
+			_, err := newReq.ValidateUpdate(webhookCtx, admission.Request{}, oldReq)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("SetContainerName() should not allow the name to change...", func() {
+			Expect(request.SetContainerName("debug-a")).To(Succeed())
+			Expect(request.SetContainerName("debug-a")).To(Succeed())
+			Expect(request.SetContainerName("debug-b")).To(Not(Succeed()))
+			Expect(request.GetContainerName()).To(Equal("debug-a"))
+		})
+	})
+
+	// Setup code below here - this code rarely changes, the tests above are
+	// much more important.
+	BeforeAll(func() {
+		By("Creating the Namespace to perform the tests")
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: testutil.RandomString(8),
+			},
+		}
+		err := k8sClient.Create(ctx, namespace)
+		Expect(err).To(Not(HaveOccurred()))
+
+		By("Creating the EphemeralContainerAccessTemplate to perform the tests")
+		template = &EphemeralContainerAccessTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: namespace.Name,
+			},
+			Spec: EphemeralContainerAccessTemplateSpec{
+				AccessConfig: AccessConfig{
+					AllowedGroups:   []string{"admins"},
+					DefaultDuration: "1h",
+					MaxDuration:     "24h",
+				},
+				ControllerTargetRef: &CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "test-deployment",
+				},
+				DebugImage: "busybox:latest",
+			},
+		}
+		err = k8sClient.Create(ctx, template)
+		Expect(err).To(Not(HaveOccurred()))
+	})
+
+	AfterAll(func() {
+		By("Deleting the Namespace for tests")
+		err := k8sClient.Delete(ctx, namespace)
+		Expect(err).To(Not(HaveOccurred()))
+	})
+})
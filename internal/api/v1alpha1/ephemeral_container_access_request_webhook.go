@@ -0,0 +1,146 @@
+/*
+Copyright 2022 Matt Wise.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/diranged/oz/internal/webhook"
+)
+
+// log is for logging in this package.
+var ephemeralcontaineraccessrequestlog = logf.Log.WithName("ephemeralcontaineraccessrequest-resource")
+
+// SetupWebhookWithManager configures the webhook service in the Manager to
+// accept MutatingWebhookConfiguration and ValidatingWebhookConfiguration calls
+// from the Kubernetes API server.
+func (r *EphemeralContainerAccessRequest) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	if err := webhook.RegisterContextualDefaulter(r, mgr); err != nil {
+		panic(err)
+	}
+	if err := webhook.RegisterContextualValidator(r, mgr); err != nil {
+		panic(err)
+	}
+
+	// boilerplate
+	return ctrl.NewWebhookManagedBy(mgr, r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-crds-wizardofoz-co-v1alpha1-ephemeralcontaineraccessrequest,mutating=true,failurePolicy=fail,sideEffects=None,groups=crds.wizardofoz.co,resources=ephemeralcontaineraccessrequests,verbs=create;update,versions=v1alpha1,name=mephemeralcontaineraccessrequest.kb.io,admissionReviewVersions=v1
+
+var _ webhook.IContextuallyDefaultableObject = &EphemeralContainerAccessRequest{}
+
+// Default records the identity of the user creating the
+// EphemeralContainerAccessRequest into Spec.requestedBy. Any value supplied by the
+// user is overwritten.
+//
+// The user that revokes the request is recorded into Spec.revokedBy in the
+// same way.
+func (r *EphemeralContainerAccessRequest) Default(_ context.Context, req admission.Request) error {
+	if req.Operation == admissionv1.Create {
+		r.Spec.RequestedBy = NewRequesterInfo(req.UserInfo)
+	}
+	return defaultRevokedBy(req, r, &EphemeralContainerAccessRequest{})
+}
+
+//+kubebuilder:webhook:path=/validate-crds-wizardofoz-co-v1alpha1-ephemeralcontaineraccessrequest,mutating=false,failurePolicy=fail,sideEffects=None,groups=crds.wizardofoz.co,resources=ephemeralcontaineraccessrequests,verbs=create;update;delete,versions=v1alpha1,name=vephemeralcontaineraccessrequest.kb.io,admissionReviewVersions=v1
+
+var _ webhook.IContextuallyValidatableObject = &EphemeralContainerAccessRequest{}
+
+// ValidateCreate verifies that the requesting user is allowed to use the
+// referenced EphemeralContainerAccessTemplate, within its concurrency limits and allowed windows.
+func (r *EphemeralContainerAccessRequest) ValidateCreate(ctx context.Context, req admission.Request) (admission.Warnings, error) {
+	ephemeralcontaineraccessrequestlog.Info(
+		fmt.Sprintf("Create EphemeralContainerAccessRequest from %s", req.UserInfo.Username),
+	)
+
+	// Every check below is made against the same Access Template.
+	tmpl, err := getRequestTemplate(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyRequesterAllowed(tmpl, req.UserInfo); err != nil {
+		return nil, err
+	}
+	if err := verifyConcurrencyLimits(ctx, r, tmpl, req.UserInfo); err != nil {
+		return nil, err
+	}
+	if err := verifyStartTime(r.Spec.StartTime, time.Now()); err != nil {
+		return nil, err
+	}
+	return nil, verifyAllowedWindows(r, tmpl)
+}
+
+// ValidateUpdate prevents immutable updates to the EphemeralContainerAccessRequest.
+func (r *EphemeralContainerAccessRequest) ValidateUpdate(ctx context.Context, req admission.Request, old runtime.Object) (admission.Warnings, error) {
+	ephemeralcontaineraccessrequestlog.Info("validate update", "name", r.Name)
+
+	// https://stackoverflow.com/questions/70650677/manage-immutable-fields-in-kubebuilder-validating-webhook
+	oldRequest, _ := old.(*EphemeralContainerAccessRequest)
+	if r.Spec.TargetPod != oldRequest.Spec.TargetPod {
+		return nil, fmt.Errorf(
+			"error - Spec.TargetPod is an immutable field, create a new EphemeralContainerAccessRequest instead",
+		)
+	}
+	if !equality.Semantic.DeepEqual(r.Spec.RequestedBy, oldRequest.Spec.RequestedBy) {
+		return nil, fmt.Errorf(
+			"error - Spec.RequestedBy is an immutable field, create a new EphemeralContainerAccessRequest instead",
+		)
+	}
+	if !equality.Semantic.DeepEqual(r.Spec.StartTime, oldRequest.Spec.StartTime) {
+		return nil, fmt.Errorf(
+			"error - Spec.StartTime is an immutable field, create a new EphemeralContainerAccessRequest instead",
+		)
+	}
+
+	// Anyone allowed to update the request may revoke it. The revoking user
+	// has already been recorded in Spec.revokedBy by the mutating webhook.
+	if err := verifyRevocationUpdate(r, oldRequest, req.UserInfo); err != nil {
+		return nil, err
+	}
+	if r.Spec.Revoked && !oldRequest.Spec.Revoked {
+		ephemeralcontaineraccessrequestlog.Info(
+			fmt.Sprintf("Revoke EphemeralContainerAccessRequest %s from %s", r.Name, req.UserInfo.Username),
+		)
+	}
+
+	// Spec.duration may be changed to extend (or shorten) the access, within
+	// the limits of the template.
+	if err := verifyDurationUpdate(ctx, r, oldRequest, req.UserInfo); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.IContextuallyValidatableObject so a webhook will be registered for the type
+func (r *EphemeralContainerAccessRequest) ValidateDelete(_ context.Context, req admission.Request) (admission.Warnings, error) {
+	ephemeralcontaineraccessrequestlog.Info(
+		fmt.Sprintf("Delete EphemeralContainerAccessRequest from %s", req.UserInfo.Username),
+	)
+	return nil, nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2022 Matt Wise.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EphemeralContainerAccessTemplateSpec defines the desired state of EphemeralContainerAccessTemplate
+type EphemeralContainerAccessTemplateSpec struct {
+	// AccessConfig provides a common struct for defining who has access to the resources this
+	// template controls, how long they have access, etc.
+	//
+	// The AccessConfig.accessCommand setting is not used by this template -
+	// the access message is always a "kubectl attach ..." command for the
+	// injected debug container.
+	AccessConfig AccessConfig `json:"accessConfig"`
+
+	// ControllerTargetRef provides a pattern for referencing objects from another API in a generic way.
+	//
+	// +kubebuilder:validation:Required
+	ControllerTargetRef *CrossVersionObjectReference `json:"controllerTargetRef"`
+
+	// DebugImage is the container image that is injected into the target Pod
+	// as an ephemeral container. This image should contain the shell and
+	// debugging tools that the user needs, eg. "busybox:latest".
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	DebugImage string `json:"debugImage"`
+
+	// TargetContainerName is the name of the container in the target Pod
+	// whose process namespace the debug container shares, allowing the user
+	// to inspect its processes and filesystem (through /proc/<pid>/root). If
+	// omitted, the debug container only shares the Pod network.
+	//
+	// +kubebuilder:validation:Optional
+	TargetContainerName string `json:"targetContainerName,omitempty"`
+}
+
+// EphemeralContainerAccessTemplateStatus defines the observed state of EphemeralContainerAccessTemplate
+type EphemeralContainerAccessTemplateStatus struct {
+	CoreStatus    `json:",inline"`
+	TemplateUsage `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// EphemeralContainerAccessTemplate is the Schema for the ephemeralcontaineraccesstemplates API
+//
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".spec.debugImage",description="Debug Image"
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Is template ready?"
+// +kubebuilder:printcolumn:name="Active",type="integer",JSONPath=".status.activeRequestCount",description="Number of active Access Requests"
+// +kubebuilder:printcolumn:name="Used",type="integer",JSONPath=".status.usageCount",description="Number of Access Requests made over the lifetime of the template"
+// +kubebuilder:printcolumn:name="Last Used",type="date",JSONPath=".status.lastUsedTime",description="Time of the most recent Access Request"
+type EphemeralContainerAccessTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EphemeralContainerAccessTemplateSpec   `json:"spec,omitempty"`
+	Status EphemeralContainerAccessTemplateStatus `json:"status,omitempty"`
+}
+
+// https://stackoverflow.com/questions/33089523/how-to-mark-golang-struct-as-implementing-interface
+var (
+	_ ITemplateResource = &EphemeralContainerAccessTemplate{}
+	_ ITemplateResource = (*EphemeralContainerAccessTemplate)(nil)
+)
+
+// GetStatus returns the core Status field for this resource.
+func (t *EphemeralContainerAccessTemplate) GetStatus() ICoreStatus {
+	return &t.Status
+}
+
+// GetAccessConfig returns the Spec.accessConfig field for this resource in an AccessConfig object form.
+func (t *EphemeralContainerAccessTemplate) GetAccessConfig() *AccessConfig {
+	return &t.Spec.AccessConfig
+}
+
+// GetTargetRef conforms to the controllers.OzTemplateResource interface.
+func (t *EphemeralContainerAccessTemplate) GetTargetRef() *CrossVersionObjectReference {
+	return t.Spec.ControllerTargetRef
+}
+
+// GetEphemeralContainerAccessTemplate returns back a EphemeralContainerAccessTemplate
+// resource matching the supplied name and namespace, or returns back an
+// error.
+func GetEphemeralContainerAccessTemplate(
+	ctx context.Context,
+	cl client.Reader,
+	name string,
+	namespace string,
+) (*EphemeralContainerAccessTemplate, error) {
+	tmpl := &EphemeralContainerAccessTemplate{}
+	err := cl.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, tmpl)
+	return tmpl, err
+}
+
+//+kubebuilder:object:root=true
+
+// EphemeralContainerAccessTemplateList contains a list of EphemeralContainerAccessTemplate
+type EphemeralContainerAccessTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EphemeralContainerAccessTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&EphemeralContainerAccessTemplate{}, &EphemeralContainerAccessTemplateList{})
+}
@@ -133,6 +133,8 @@ var _ = Describe("ExecAccessRequest", Ordered, func() {
 		// package so that we can write one set of tests for all of the
 		// Validate* functions.
 		It("Create with UserInfo...", func() {
+			request.Namespace = template.Namespace
+			request.Spec.TemplateName = template.Name
 			requestBytes, _ := json.Marshal(request)
 			admissionRequest = &admission.Request{
 				AdmissionRequest: admissionv1.AdmissionRequest{
@@ -145,7 +147,7 @@ var _ = Describe("ExecAccessRequest", Ordered, func() {
 					UserInfo: authenticationv1.UserInfo{
 						Username: "admin",
 						UID:      "",
-						Groups:   []string{},
+						Groups:   []string{"admins"},
 						Extra: map[string]authenticationv1.ExtraValue{
 							"": {},
 						},
@@ -155,11 +157,133 @@ var _ = Describe("ExecAccessRequest", Ordered, func() {
 					},
 				},
 			}
-			_, err = request.ValidateCreate(*admissionRequest)
+			_, err = request.ValidateCreate(webhookCtx, *admissionRequest)
 			Expect(err).To(Not(HaveOccurred()))
 		})
 
+		It("Create with UserInfo outside of the AllowedGroups...", func() {
+			request.Namespace = template.Namespace
+			request.Spec.TemplateName = template.Name
+			requestBytes, _ := json.Marshal(request)
+			admissionRequest = &admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Resource:        gvr,
+					RequestKind:     &gvk,
+					RequestResource: &gvr,
+					Name:            requestName,
+					Namespace:       namespace.Name,
+					Operation:       "CREATE",
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						UID:      "",
+						Groups:   []string{"others"},
+						Extra: map[string]authenticationv1.ExtraValue{
+							"": {},
+						},
+					},
+					Object: runtime.RawExtension{
+						Raw: requestBytes,
+					},
+				},
+			}
+			_, err = request.ValidateCreate(webhookCtx, *admissionRequest)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not a member of any of the allowedGroups"))
+		})
+
+		It("Create beyond the concurrency limits of the template...", func() {
+			By("Limiting the template to a single live request")
+			template.Spec.AccessConfig.MaxConcurrentRequests = 1
+			Expect(k8sClient.Update(ctx, template)).To(Succeed())
+
+			existing := &ExecAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "concurrency-test",
+					Namespace: template.Namespace,
+				},
+				Spec: ExecAccessRequestSpec{TemplateName: template.Name},
+			}
+			Expect(k8sClient.Create(ctx, existing)).To(Succeed())
+			defer func() { Expect(k8sClient.Delete(ctx, existing)).To(Succeed()) }()
+
+			By("Creating another request")
+			another := &ExecAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "concurrency-test-2",
+					Namespace: template.Namespace,
+				},
+				Spec: ExecAccessRequestSpec{TemplateName: template.Name},
+			}
+			Eventually(func() error {
+				_, err := another.ValidateCreate(webhookCtx, admission.Request{
+					AdmissionRequest: admissionv1.AdmissionRequest{
+						Operation: "CREATE",
+						UserInfo: authenticationv1.UserInfo{
+							Username: "admin",
+							Groups:   []string{"admins"},
+						},
+					},
+				})
+				return err
+			}, time.Minute, time.Second).Should(MatchError(ContainSubstring("maxConcurrentRequests: 1")))
+
+			By("Scheduling the other request to start later instead")
+			another.Spec.StartTime = &metav1.Time{Time: time.Now().Add(time.Hour)}
+			_, err := another.ValidateCreate(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: "CREATE",
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						Groups:   []string{"admins"},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Create outside of the allowed windows of the template...", func() {
+			By("Limiting the template to a window that is never open today")
+			closedDay := time.Now().UTC().AddDate(0, 0, 2).Format("Mon")
+			template.Spec.AccessConfig.AllowedWindows = []AccessWindow{
+				{Days: []Weekday{Weekday(closedDay)}, Start: "00:00", End: "01:00"},
+			}
+			Expect(k8sClient.Update(ctx, template)).To(Succeed())
+
+			windowed := &ExecAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "windows-test",
+					Namespace: template.Namespace,
+				},
+				Spec: ExecAccessRequestSpec{TemplateName: template.Name},
+			}
+			admissionRequest := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: "CREATE",
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						Groups:   []string{"admins"},
+					},
+				},
+			}
+			Eventually(func() error {
+				_, err := windowed.ValidateCreate(webhookCtx, admissionRequest)
+				return err
+			}, time.Minute, time.Second).Should(MatchError(ContainSubstring("can only start during its allowedWindows")))
+
+			By("Scheduling the request to start within the window")
+			startTime := time.Now().UTC().AddDate(0, 0, 2)
+			startTime = time.Date(startTime.Year(), startTime.Month(), startTime.Day(), 0, 30, 0, 0, time.UTC)
+			windowed.Spec.StartTime = &metav1.Time{Time: startTime}
+			_, err = windowed.ValidateCreate(webhookCtx, admissionRequest)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("Create without UserInfo...", func() {
+			// Point the request at the real template, so that the request
+			// is denied because of the empty groups - not because the
+			// template lookup fails.
+			request.Namespace = template.Namespace
+			request.Spec.TemplateName = template.Name
 			requestBytes, _ := json.Marshal(request)
 			admissionRequest = &admission.Request{
 				AdmissionRequest: admissionv1.AdmissionRequest{
@@ -175,8 +299,9 @@ var _ = Describe("ExecAccessRequest", Ordered, func() {
 					},
 				},
 			}
-			_, err = request.ValidateCreate(*admissionRequest)
-			Expect(err).To(Not(HaveOccurred()))
+			_, err = request.ValidateCreate(webhookCtx, *admissionRequest)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not a member of any of the allowedGroups"))
 		})
 
 		It("Update with UserInfo...", func() {
@@ -202,7 +327,7 @@ var _ = Describe("ExecAccessRequest", Ordered, func() {
 					},
 				},
 			}
-			_, err = request.ValidateUpdate(*admissionRequest, request)
+			_, err = request.ValidateUpdate(webhookCtx, *admissionRequest, request)
 			Expect(err).To(Not(HaveOccurred()))
 		})
 
@@ -222,8 +347,111 @@ var _ = Describe("ExecAccessRequest", Ordered, func() {
 					},
 				},
 			}
-			_, err = request.ValidateUpdate(*admissionRequest, request)
+			_, err = request.ValidateUpdate(webhookCtx, *admissionRequest, request)
+			Expect(err).To(Not(HaveOccurred()))
+		})
+
+		It("Default() should record the requester on create...", func() {
+			req := request.DeepCopy()
+			req.Spec.RequestedBy = &RequesterInfo{Username: "forged"}
+			err = req.Default(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						UID:      "1234",
+						Groups:   []string{"admins"},
+					},
+				},
+			})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(req.GetRequestedBy()).To(Equal(&RequesterInfo{
+				Username: "admin",
+				UID:      "1234",
+				Groups:   []string{"admins"},
+			}))
+		})
+
+		It("Default() should not touch the requester on update...", func() {
+			req := request.DeepCopy()
+			req.Spec.RequestedBy = &RequesterInfo{Username: "admin"}
+			err = req.Default(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Update,
+					UserInfo:  authenticationv1.UserInfo{Username: "other"},
+				},
+			})
 			Expect(err).To(Not(HaveOccurred()))
+			Expect(req.GetRequestedBy().Username).To(Equal("admin"))
+		})
+
+		It("ValidateUpdate() should reject changes to Spec.RequestedBy...", func() {
+			oldReq := request.DeepCopy()
+			oldReq.Spec.RequestedBy = &RequesterInfo{Username: "admin"}
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.RequestedBy.Username = "other"
+			_, err = newReq.ValidateUpdate(webhookCtx, admission.Request{}, oldReq)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("ValidateUpdate() should reject changes to Spec.StartTime...", func() {
+			oldReq := request.DeepCopy()
+			startTime := metav1.NewTime(time.Now().Add(time.Hour))
+			oldReq.Spec.StartTime = &startTime
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.StartTime = &metav1.Time{Time: startTime.Add(time.Hour)}
+			_, err = newReq.ValidateUpdate(webhookCtx, admission.Request{}, oldReq)
+			Expect(err).To(MatchError(ContainSubstring("Spec.StartTime is an immutable field")))
+		})
+
+		It("GetUptime() should be measured from Spec.StartTime...", func() {
+			req := request.DeepCopy()
+			req.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+			Expect(req.GetStartTime()).To(Equal(req.CreationTimestamp.Time))
+			Expect(req.GetUptime()).To(BeNumerically("~", time.Hour, time.Minute))
+
+			startTime := metav1.NewTime(time.Now().Add(time.Hour))
+			req.Spec.StartTime = &startTime
+			Expect(req.GetStartTime()).To(Equal(startTime.Time))
+			Expect(req.GetUptime()).To(BeNumerically("~", -time.Hour, time.Minute))
+		})
+
+		It("GetStartTime() should ignore a backdated Spec.StartTime...", func() {
+			req := request.DeepCopy()
+			req.CreationTimestamp = metav1.NewTime(time.Now())
+			startTime := metav1.NewTime(time.Now().Add(-24 * time.Hour))
+			req.Spec.StartTime = &startTime
+			Expect(req.GetStartTime()).To(Equal(req.CreationTimestamp.Time))
+			Expect(req.GetUptime()).To(BeNumerically("~", 0, time.Minute))
+		})
+
+		It("ValidateCreate() should reject a backdated Spec.StartTime...", func() {
+			now := time.Now()
+			Expect(verifyStartTime(nil, now)).To(Succeed())
+			Expect(verifyStartTime(&metav1.Time{Time: now.Add(time.Hour)}, now)).To(Succeed())
+			Expect(verifyStartTime(&metav1.Time{Time: now.Add(-30 * time.Second)}, now)).To(Succeed())
+
+			backdated := &ExecAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "backdated",
+					Namespace: template.Namespace,
+				},
+				Spec: ExecAccessRequestSpec{
+					TemplateName: template.Name,
+					StartTime:    &metav1.Time{Time: now.Add(-time.Hour)},
+				},
+			}
+			_, err := backdated.ValidateCreate(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: "CREATE",
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						Groups:   []string{"admins"},
+					},
+				},
+			})
+			Expect(err).To(MatchError(ContainSubstring("spec.startTime")))
+			Expect(err).To(MatchError(ContainSubstring("is in the past")))
 		})
 	})
 
@@ -292,7 +520,9 @@ var _ = Describe("ExecAccessRequest", Ordered, func() {
 			},
 			Spec: ExecAccessTemplateSpec{
 				AccessConfig: AccessConfig{
-					AllowedGroups:   []string{"admins"},
+					// system:masters is the group of the envtest user that
+					// creates the requests in the Reconciliation tests above.
+					AllowedGroups:   []string{"admins", "system:masters"},
 					DefaultDuration: "1h",
 					MaxDuration:     "24h",
 				},
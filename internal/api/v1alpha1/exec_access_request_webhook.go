@@ -17,8 +17,12 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -50,17 +54,25 @@ func (r *ExecAccessRequest) SetupWebhookWithManager(mgr ctrl.Manager) error {
 
 var _ webhook.IContextuallyDefaultableObject = &ExecAccessRequest{}
 
-// Default implements webhook.Defaulter so a webhook will be registered for the type
-func (r *ExecAccessRequest) Default(_ admission.Request) error {
-	return nil
+// Default records the identity of the user creating the ExecAccessRequest into
+// Spec.requestedBy. Any value supplied by the user is overwritten.
+//
+// The user that revokes the request is recorded into Spec.revokedBy in the
+// same way.
+func (r *ExecAccessRequest) Default(_ context.Context, req admission.Request) error {
+	if req.Operation == admissionv1.Create {
+		r.Spec.RequestedBy = NewRequesterInfo(req.UserInfo)
+	}
+	return defaultRevokedBy(req, r, &ExecAccessRequest{})
 }
 
 //+kubebuilder:webhook:path=/validate-crds-wizardofoz-co-v1alpha1-execaccessrequest,mutating=false,failurePolicy=fail,sideEffects=None,groups=crds.wizardofoz.co,resources=execaccessrequests,verbs=create;update;delete,versions=v1alpha1,name=vexecaccessrequest.kb.io,admissionReviewVersions=v1
 
 var _ webhook.IContextuallyValidatableObject = &ExecAccessRequest{}
 
-// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
-func (r *ExecAccessRequest) ValidateCreate(req admission.Request) (admission.Warnings, error) {
+// ValidateCreate verifies that the requesting user is allowed to use the
+// referenced ExecAccessTemplate, within its concurrency limits and allowed windows.
+func (r *ExecAccessRequest) ValidateCreate(ctx context.Context, req admission.Request) (admission.Warnings, error) {
 	warnings := admission.Warnings{}
 	if req.UserInfo.Username != "" {
 		execaccessrequestlog.Info(
@@ -72,11 +84,39 @@ func (r *ExecAccessRequest) ValidateCreate(req admission.Request) (admission.War
 		warnings = append(warnings, w)
 		execaccessrequestlog.Info(w)
 	}
+
+	// Every check below is made against the same Access Template.
+	tmpl, err := getRequestTemplate(ctx, r)
+	if err != nil {
+		return warnings, err
+	}
+
+	// Only members of the template's AllowedGroups may request access
+	// through it - otherwise we would create resources that the requester
+	// cannot even use.
+	if err := verifyRequesterAllowed(tmpl, req.UserInfo); err != nil {
+		return warnings, err
+	}
+
+	// The template may limit how many requests can be live at once.
+	if err := verifyConcurrencyLimits(ctx, r, tmpl, req.UserInfo); err != nil {
+		return warnings, err
+	}
+
+	// Access can be scheduled for later, but never backdated.
+	if err := verifyStartTime(r.Spec.StartTime, time.Now()); err != nil {
+		return warnings, err
+	}
+
+	// The template may only be usable at certain times.
+	if err := verifyAllowedWindows(r, tmpl); err != nil {
+		return warnings, err
+	}
 	return warnings, nil
 }
 
 // ValidateUpdate prevents immutable updates to the ExecAccessRequest.
-func (r *ExecAccessRequest) ValidateUpdate(_ admission.Request, old runtime.Object) (admission.Warnings, error) {
+func (r *ExecAccessRequest) ValidateUpdate(ctx context.Context, req admission.Request, old runtime.Object) (admission.Warnings, error) {
 	execaccessrequestlog.Info("validate update", "name", r.Name)
 
 	// https://stackoverflow.com/questions/70650677/manage-immutable-fields-in-kubebuilder-validating-webhook
@@ -86,11 +126,38 @@ func (r *ExecAccessRequest) ValidateUpdate(_ admission.Request, old runtime.Obje
 			"error - Spec.TargetPod is an immutable field, create a new PodAccessRequest instead",
 		)
 	}
+	if !equality.Semantic.DeepEqual(r.Spec.RequestedBy, oldRequest.Spec.RequestedBy) {
+		return nil, fmt.Errorf(
+			"error - Spec.RequestedBy is an immutable field, create a new ExecAccessRequest instead",
+		)
+	}
+	if !equality.Semantic.DeepEqual(r.Spec.StartTime, oldRequest.Spec.StartTime) {
+		return nil, fmt.Errorf(
+			"error - Spec.StartTime is an immutable field, create a new ExecAccessRequest instead",
+		)
+	}
+
+	// Anyone allowed to update the request may revoke it. The revoking user
+	// has already been recorded in Spec.revokedBy by the mutating webhook.
+	if err := verifyRevocationUpdate(r, oldRequest, req.UserInfo); err != nil {
+		return nil, err
+	}
+	if r.Spec.Revoked && !oldRequest.Spec.Revoked {
+		execaccessrequestlog.Info(
+			fmt.Sprintf("Revoke ExecAccessRequest %s from %s", r.Name, req.UserInfo.Username),
+		)
+	}
+
+	// Spec.duration may be changed to extend (or shorten) the access, within
+	// the limits of the template.
+	if err := verifyDurationUpdate(ctx, r, oldRequest, req.UserInfo); err != nil {
+		return nil, err
+	}
 	return nil, nil
 }
 
 // ValidateDelete implements webhook.IContextuallyValidatableObject so a webhook will be registered for the type
-func (r *ExecAccessRequest) ValidateDelete(req admission.Request) (admission.Warnings, error) {
+func (r *ExecAccessRequest) ValidateDelete(_ context.Context, req admission.Request) (admission.Warnings, error) {
 	execaccessrequestlog.Info(
 		fmt.Sprintf("Delete ExecAccessRequest from %s", req.UserInfo.Username),
 	)
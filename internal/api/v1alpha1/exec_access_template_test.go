@@ -0,0 +1,113 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("ExecAccessTemplate", func() {
+	var template *ExecAccessTemplate
+
+	BeforeEach(func() {
+		template = &ExecAccessTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "default",
+			},
+			Spec: ExecAccessTemplateSpec{
+				AccessConfig: AccessConfig{
+					AllowedGroups:   []string{"admins"},
+					DefaultDuration: "1h",
+					MaxDuration:     "24h",
+				},
+				ControllerTargetRef: &CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "test-deployment",
+				},
+			},
+		}
+	})
+
+	It("Validate() should accept a valid template", func() {
+		Expect(template.Validate()).To(Succeed())
+
+		template.Spec.ControllerTargetRef = &CrossVersionObjectReference{
+			APIVersion: "argoproj.io/v1alpha1",
+			Kind:       "Rollout",
+			Name:       "test-rollout",
+		}
+		Expect(template.Validate()).To(Succeed())
+	})
+
+	It("Validate() should require a controllerTargetRef", func() {
+		template.Spec.ControllerTargetRef = nil
+		Expect(template.Validate()).To(MatchError(ContainSubstring("must be set")))
+
+		template.Spec.ControllerTargetRef = &CrossVersionObjectReference{}
+		Expect(template.Validate()).To(MatchError(ContainSubstring("must be set")))
+	})
+
+	It("Validate() should reject unsupported controllerTargetRefs", func() {
+		template.Spec.ControllerTargetRef.Kind = "Pod"
+		Expect(template.Validate()).To(MatchError(ContainSubstring("is not supported")))
+
+		template.Spec.ControllerTargetRef.Kind = "StatefulSet"
+		template.Spec.ControllerTargetRef.APIVersion = "apps"
+		Expect(template.Validate()).To(MatchError(ContainSubstring("group/version")))
+	})
+
+	It("Validate() should reject invalid durations", func() {
+		template.Spec.AccessConfig.MaxDuration = "forever"
+		Expect(template.Validate()).To(
+			MatchError(ContainSubstring("invalid spec.accessConfig.maxDuration")),
+		)
+
+		template.Spec.AccessConfig.MaxDuration = "-1h"
+		Expect(template.Validate()).To(MatchError(ContainSubstring("must be positive")))
+
+		template.Spec.AccessConfig.MaxDuration = "30m"
+		Expect(template.Validate()).To(
+			MatchError(ContainSubstring("can not be greater than spec.accessConfig.maxDuration")),
+		)
+	})
+
+	It("Validate() should reject invalid allowedWindows", func() {
+		template.Spec.AccessConfig.AllowedWindows = []AccessWindow{
+			{Days: []Weekday{"Mon"}, Start: "09:00", End: "17:00", TimeZone: "Europe/London"},
+		}
+		Expect(template.Validate()).To(Succeed())
+
+		template.Spec.AccessConfig.AllowedWindows[0].TimeZone = "Europe/Nowhere"
+		Expect(template.Validate()).To(
+			MatchError(ContainSubstring("invalid spec.accessConfig.allowedWindows[0]: invalid timeZone")),
+		)
+	})
+
+	It("ValidateCreate() and ValidateUpdate() should call Validate()", func() {
+		_, err := template.ValidateCreate(webhookCtx, admission.Request{})
+		Expect(err).To(Not(HaveOccurred()))
+		_, err = template.ValidateUpdate(webhookCtx, admission.Request{}, template.DeepCopy())
+		Expect(err).To(Not(HaveOccurred()))
+
+		template.Spec.AccessConfig.DefaultDuration = "48h"
+		_, err = template.ValidateCreate(webhookCtx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+		_, err = template.ValidateUpdate(webhookCtx, admission.Request{}, template.DeepCopy())
+		Expect(err).To(HaveOccurred())
+
+		_, err = template.ValidateDelete(webhookCtx, admission.Request{})
+		Expect(err).To(Not(HaveOccurred()))
+	})
+
+	It("should be rejected by the webhook when invalid", func() {
+		template.Name = "invalid-exec-access-template"
+		template.Spec.ControllerTargetRef.Kind = "CronJob"
+		err := k8sClient.Create(ctx, template)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("is not supported"))
+	})
+})
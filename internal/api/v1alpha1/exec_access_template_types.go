@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"context"
+	"errors"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -34,12 +35,20 @@ type ExecAccessTemplateSpec struct {
 	//
 	// +kubebuilder:validation:Required
 	ControllerTargetRef *CrossVersionObjectReference `json:"controllerTargetRef"`
+
+	// AccessRules optionally replaces the default permissions (read access
+	// to the target Pod, plus "pods/exec") that are granted by each Access
+	// Request. Rules are always scoped to the target Pod of the request.
+	//
+	// +kubebuilder:validation:Optional
+	AccessRules []AccessRule `json:"accessRules,omitempty"`
 }
 
 // ExecAccessTemplateStatus is the core set of status fields that we expect to be in each and every one of
 // our template (AccessTemplate, ExecAccessTemplate, etc) resources.
 type ExecAccessTemplateStatus struct {
-	CoreStatus `json:",inline"`
+	CoreStatus    `json:",inline"`
+	TemplateUsage `json:",inline"`
 }
 
 //+kubebuilder:object:root=true
@@ -48,6 +57,9 @@ type ExecAccessTemplateStatus struct {
 // ExecAccessTemplate is the Schema for the execaccesstemplates API
 //
 // +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Is template ready?"
+// +kubebuilder:printcolumn:name="Active",type="integer",JSONPath=".status.activeRequestCount",description="Number of active Access Requests"
+// +kubebuilder:printcolumn:name="Used",type="integer",JSONPath=".status.usageCount",description="Number of Access Requests made over the lifetime of the template"
+// +kubebuilder:printcolumn:name="Last Used",type="date",JSONPath=".status.lastUsedTime",description="Time of the most recent Access Request"
 type ExecAccessTemplate struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -76,11 +88,29 @@ func (t *ExecAccessTemplate) GetAccessConfig() *AccessConfig {
 	return &t.Spec.AccessConfig
 }
 
+// GetAccessRules returns the Spec.accessRules field, or the
+// DefaultAccessRules if none are set.
+func (t *ExecAccessTemplate) GetAccessRules() []AccessRule {
+	if len(t.Spec.AccessRules) == 0 {
+		return DefaultAccessRules()
+	}
+	return t.Spec.AccessRules
+}
+
 // GetTargetRef conforms to the controllers.OzTemplateResource interface.
 func (t *ExecAccessTemplate) GetTargetRef() *CrossVersionObjectReference {
 	return t.Spec.ControllerTargetRef
 }
 
+// Validate the inputs. Spec.controllerTargetRef must point to a supported
+// controller, and the durations and accessRules must be valid.
+func (t *ExecAccessTemplate) Validate() error {
+	if !hasTargetRef(t) {
+		return errors.New("spec.controllerTargetRef must be set")
+	}
+	return validateTemplate(t, t.Spec.AccessRules)
+}
+
 // GetExecAccessTemplate returns back an ExecAccessTemplate resource matching the request supplied to the reconciler loop, or returns back an error.
 func GetExecAccessTemplate(
 	ctx context.Context,
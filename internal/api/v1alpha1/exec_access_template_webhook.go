@@ -0,0 +1,70 @@
+/*
+Copyright 2022 Matt Wise.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/diranged/oz/internal/webhook"
+)
+
+// log is for logging in this package.
+var execaccesstemplatelog = logf.Log.WithName("execaccesstemplate-resource")
+
+// SetupWebhookWithManager configures the webhook service in the Manager to
+// accept ValidatingWebhookConfiguration calls from the Kubernetes API server.
+func (t *ExecAccessTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	if err := webhook.RegisterContextualValidator(t, mgr); err != nil {
+		panic(err)
+	}
+
+	// boilerplate
+	return ctrl.NewWebhookManagedBy(mgr, t).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-crds-wizardofoz-co-v1alpha1-execaccesstemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=crds.wizardofoz.co,resources=execaccesstemplates,verbs=create;update,versions=v1alpha1,name=vexecaccesstemplate.kb.io,admissionReviewVersions=v1
+
+var _ webhook.IContextuallyValidatableObject = &ExecAccessTemplate{}
+
+// ValidateCreate rejects ExecAccessTemplates that fail Validate().
+func (t *ExecAccessTemplate) ValidateCreate(_ context.Context, req admission.Request) (admission.Warnings, error) {
+	execaccesstemplatelog.Info(
+		fmt.Sprintf("Create ExecAccessTemplate %s from %s", t.Name, req.UserInfo.Username),
+	)
+	return nil, t.Validate()
+}
+
+// ValidateUpdate rejects updates that would leave the ExecAccessTemplate
+// failing Validate().
+func (t *ExecAccessTemplate) ValidateUpdate(_ context.Context, req admission.Request, _ runtime.Object) (admission.Warnings, error) {
+	execaccesstemplatelog.Info(
+		fmt.Sprintf("Update ExecAccessTemplate %s from %s", t.Name, req.UserInfo.Username),
+	)
+	return nil, t.Validate()
+}
+
+// ValidateDelete implements webhook.IContextuallyValidatableObject so a webhook will be registered for the type
+func (t *ExecAccessTemplate) ValidateDelete(_ context.Context, _ admission.Request) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -28,14 +28,17 @@ type IRequestStatus interface {
 	ICoreStatus
 	SetAccessMessage(string)
 	GetAccessMessage() string
+	SetExpiresAt(metav1.Time)
+	GetExpiresAt() *metav1.Time
 }
 
 // ITemplateStatus provides a more specific Status interface for Access
-// Templates. Functionality to come in the future.
+// Templates, that exposes how the template is being used.
 //
 // +kubebuilder:object:generate=false
 type ITemplateStatus interface {
 	ICoreStatus
+	GetUsage() *TemplateUsage
 }
 
 // The ICoreResource interface wraps a standard client.Object resource (metav1.Object + runtime.Object)
@@ -83,8 +86,25 @@ type IRequestResource interface {
 	// Returns the Spec.duration in time.Duration() format, or nil.
 	GetDuration() (time.Duration, error)
 
-	// Returns the uptime in time.Duration() format
+	// Returns the time at which the access starts - the Spec.startTime, or
+	// the creation time of the request if that is not set
+	GetStartTime() time.Time
+
+	// Returns how long the access has been running for in time.Duration()
+	// format. This is negative while the Spec.startTime is in the future.
 	GetUptime() time.Duration
+
+	// Returns the identity of the user that created the request, or nil
+	GetRequestedBy() *RequesterInfo
+
+	// Returns true if the Spec.revoked field has been set
+	IsRevoked() bool
+
+	// Returns the identity of the user that revoked the request, or nil
+	GetRevokedBy() *RequesterInfo
+
+	// Sets the Spec.revokedBy field
+	SetRevokedBy(*RequesterInfo)
 }
 
 // IPodRequestResource is a Pod-access specific request interface that exposes a few more functions
@@ -0,0 +1,267 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/diranged/oz/internal/testing/utils"
+)
+
+var _ = Describe("LogAccessRequest", Ordered, func() {
+	var (
+		namespace *corev1.Namespace
+		template  *LogAccessTemplate
+		request   *LogAccessRequest
+	)
+
+	// This Context() tests specific functions - no real calls against the API
+	// are made here, other than the lookup of the LogAccessTemplate.
+	Context("Functional Unit Tests", func() {
+		BeforeEach(func() {
+			request = &LogAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: namespace.Name,
+				},
+				Spec: LogAccessRequestSpec{
+					TemplateName: template.Name,
+				},
+			}
+		})
+
+		It("Default() should record the requester on create...", func() {
+			err := request.Default(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						Groups:   []string{"admins"},
+					},
+				},
+			})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(request.GetRequestedBy().Username).To(Equal("admin"))
+		})
+
+		It("ValidateCreate() should allow members of the allowedGroups...", func() {
+			_, err := request.ValidateCreate(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						Groups:   []string{"admins"},
+					},
+				},
+			})
+			Expect(err).To(Not(HaveOccurred()))
+		})
+
+		It("ValidateCreate() should reject users outside of the allowedGroups...", func() {
+			_, err := request.ValidateCreate(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						Groups:   []string{"others"},
+					},
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not a member of any of the allowedGroups"))
+		})
+
+		It("ValidateUpdate() should reject changes to Spec.RequestedBy...", func() {
+			oldReq := request.DeepCopy()
+			oldReq.Spec.RequestedBy = &RequesterInfo{Username: "admin"}
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.RequestedBy.Username = "other"
+			_, err := newReq.ValidateUpdate(webhookCtx, admission.Request{}, oldReq)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("ValidateUpdate() should allow the requester to extend Spec.Duration...", func() {
+			oldReq := request.DeepCopy()
+			oldReq.CreationTimestamp = metav1.Now()
+			oldReq.Spec.RequestedBy = &RequesterInfo{Username: "admin"}
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.Duration = "2h"
+			_, err := newReq.ValidateUpdate(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "admin"},
+				},
+			}, oldReq)
+			Expect(err).To(Not(HaveOccurred()))
+		})
+
+		It("ValidateUpdate() should reject Spec.Duration changes from other users...", func() {
+			oldReq := request.DeepCopy()
+			oldReq.CreationTimestamp = metav1.Now()
+			oldReq.Spec.RequestedBy = &RequesterInfo{Username: "admin"}
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.Duration = "2h"
+			_, err := newReq.ValidateUpdate(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "other"},
+				},
+			}, oldReq)
+			Expect(err).To(MatchError(ContainSubstring("only the requester (admin)")))
+		})
+
+		It("ValidateUpdate() should reject Spec.Duration changes without a known requester...", func() {
+			oldReq := request.DeepCopy()
+			oldReq.CreationTimestamp = metav1.Now()
+			oldReq.Spec.RequestedBy = nil
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.Duration = "2h"
+			_, err := newReq.ValidateUpdate(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "anyone"},
+				},
+			}, oldReq)
+			Expect(err).To(MatchError(ContainSubstring("has no known requester")))
+		})
+
+		It("ValidateUpdate() should reject a Spec.Duration above the template maxDuration...", func() {
+			oldReq := request.DeepCopy()
+			oldReq.CreationTimestamp = metav1.Now()
+			oldReq.Spec.RequestedBy = &RequesterInfo{Username: "admin"}
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.Duration = "25h"
+			_, err := newReq.ValidateUpdate(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "admin"},
+				},
+			}, oldReq)
+			Expect(err).To(MatchError(ContainSubstring("is above the maxDuration (24h0m0s)")))
+		})
+
+		It("ValidateUpdate() should reject extending an expired request...", func() {
+			oldReq := request.DeepCopy()
+			oldReq.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+			oldReq.Spec.RequestedBy = &RequesterInfo{Username: "admin"}
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.Duration = "3h"
+			_, err := newReq.ValidateUpdate(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "admin"},
+				},
+			}, oldReq)
+			Expect(err).To(MatchError(ContainSubstring("has already expired")))
+		})
+
+		It("Default() should record the revoking user on update...", func() {
+			oldReq := request.DeepCopy()
+			oldReq.Spec.RequestedBy = &RequesterInfo{Username: "admin"}
+			raw, err := json.Marshal(oldReq)
+			Expect(err).To(Not(HaveOccurred()))
+
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.Revoked = true
+			newReq.Spec.RevokedBy = &RequesterInfo{Username: "someone-else"}
+			err = newReq.Default(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Update,
+					UserInfo:  authenticationv1.UserInfo{Username: "security"},
+					OldObject: runtime.RawExtension{Raw: raw},
+				},
+			})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(newReq.GetRevokedBy().Username).To(Equal("security"))
+			Expect(newReq.GetRequestedBy().Username).To(Equal("admin"))
+		})
+
+		It("ValidateUpdate() should allow other users to revoke the request...", func() {
+			oldReq := request.DeepCopy()
+			oldReq.Spec.RequestedBy = &RequesterInfo{Username: "admin"}
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.Revoked = true
+			newReq.Spec.RevokedBy = &RequesterInfo{Username: "security"}
+			_, err := newReq.ValidateUpdate(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "security"},
+				},
+			}, oldReq)
+			Expect(err).To(Not(HaveOccurred()))
+		})
+
+		It("ValidateUpdate() should reject a Spec.RevokedBy that is not the revoking user...", func() {
+			oldReq := request.DeepCopy()
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.Revoked = true
+			newReq.Spec.RevokedBy = &RequesterInfo{Username: "someone-else"}
+			_, err := newReq.ValidateUpdate(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "security"},
+				},
+			}, oldReq)
+			Expect(err).To(MatchError(ContainSubstring("must identify the revoking user (security)")))
+		})
+
+		It("ValidateUpdate() should reject un-revoking a request...", func() {
+			oldReq := request.DeepCopy()
+			oldReq.Spec.Revoked = true
+			oldReq.Spec.RevokedBy = &RequesterInfo{Username: "security"}
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.Revoked = false
+			_, err := newReq.ValidateUpdate(webhookCtx, admission.Request{}, oldReq)
+			Expect(err).To(MatchError(ContainSubstring("has been revoked")))
+
+			// VERIFY: The revoker cannot be rewritten either
+			newReq = oldReq.DeepCopy()
+			newReq.Spec.RevokedBy.Username = "other"
+			_, err = newReq.ValidateUpdate(webhookCtx, admission.Request{}, oldReq)
+			Expect(err).To(MatchError(ContainSubstring("Spec.RevokedBy is an immutable field")))
+		})
+	})
+
+	// Setup code below here - this code rarely changes, the tests above are
+	// much more important.
+	BeforeAll(func() {
+		By("Creating the Namespace to perform the tests")
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: testutil.RandomString(8),
+			},
+		}
+		err := k8sClient.Create(ctx, namespace)
+		Expect(err).To(Not(HaveOccurred()))
+
+		By("Creating the LogAccessTemplate to perform the tests")
+		template = &LogAccessTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: namespace.Name,
+			},
+			Spec: LogAccessTemplateSpec{
+				AccessConfig: AccessConfig{
+					AllowedGroups:   []string{"admins"},
+					DefaultDuration: "1h",
+					MaxDuration:     "24h",
+				},
+				ControllerTargetRef: &CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "test-deployment",
+				},
+			},
+		}
+		err = k8sClient.Create(ctx, template)
+		Expect(err).To(Not(HaveOccurred()))
+	})
+
+	AfterAll(func() {
+		By("Deleting the Namespace for tests")
+		err := k8sClient.Delete(ctx, namespace)
+		Expect(err).To(Not(HaveOccurred()))
+	})
+})
@@ -0,0 +1,183 @@
+/*
+Copyright 2022 Matt Wise.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LogAccessRequestSpec defines the desired state of LogAccessRequest
+type LogAccessRequestSpec struct {
+	// Defines the name of the `LogAccessTemplate` that should be used
+	// to grant access to the target resource.
+	//
+	// +kubebuilder:validation:Required
+	TemplateName string `json:"templateName"`
+
+	// Duration sets the length of time from the `spec.startTime` (or the `metadata.creationTimestamp`
+	// if no start time is set) that this object will live. After the time has expired, the resouce
+	// will be automatically deleted on the next reconcilliation loop.
+	//
+	// If omitted, the spec.defautlDuration from the LogAccessTemplate is used.
+	//
+	// Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	Duration string `json:"duration,omitempty"`
+
+	// StartTime schedules the access to begin at a later time. Until then,
+	// the request is kept in a Scheduled state and no access resources are
+	// created. The Spec.duration is measured from this time, and it cannot
+	// be changed after creation. It cannot be in the past - access cannot
+	// be backdated.
+	//
+	// If omitted, the access begins as soon as the request is created.
+	//
+	// +kubebuilder:validation:Optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// RequestedBy records the identity of the user that created this
+	// request. This field is set by the Oz admission webhook - any value
+	// supplied by the user is overwritten, and it cannot be changed after
+	// creation.
+	//
+	// +kubebuilder:validation:Optional
+	RequestedBy *RequesterInfo `json:"requestedBy,omitempty"`
+
+	// Revoked ends the access granted by this request early. Once set it
+	// cannot be unset, and the request is deleted on the next reconciliation
+	// loop.
+	//
+	// +kubebuilder:validation:Optional
+	Revoked bool `json:"revoked,omitempty"`
+
+	// RevokedBy records the identity of the user that revoked this request.
+	// This field is set by the Oz admission webhook - any value supplied by
+	// the user is overwritten.
+	//
+	// +kubebuilder:validation:Optional
+	RevokedBy *RequesterInfo `json:"revokedBy,omitempty"`
+}
+
+// LogAccessRequestStatus defines the observed state of LogAccessRequest
+type LogAccessRequestStatus struct {
+	CoreStatus `json:",inline"`
+
+	// The names of the Pods whose logs can currently be read. This list is
+	// kept up to date as Pods of the target controller come and go.
+	PodNames []string `json:"podNames,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// LogAccessRequest is the Schema for the logaccessrequests API
+//
+// +kubebuilder:printcolumn:name="Template",type="string",JSONPath=".spec.templateName",description="Access Template"
+// +kubebuilder:printcolumn:name="User",type="string",JSONPath=".spec.requestedBy.username",description="Requesting User"
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Is request ready?"
+// +kubebuilder:printcolumn:name="Starts",type="string",format="date-time",JSONPath=".spec.startTime",description="When the access starts",priority=1
+// +kubebuilder:printcolumn:name="Expires",type="string",format="date-time",JSONPath=".status.expiresAt",description="When the access expires"
+type LogAccessRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogAccessRequestSpec   `json:"spec,omitempty"`
+	Status LogAccessRequestStatus `json:"status,omitempty"`
+}
+
+// https://stackoverflow.com/questions/33089523/how-to-mark-golang-struct-as-implementing-interface
+var (
+	_ IRequestResource = &LogAccessRequest{}
+	_ IRequestResource = (*LogAccessRequest)(nil)
+)
+
+// GetStatus implements the ICoreResource interface
+func (r *LogAccessRequest) GetStatus() ICoreStatus {
+	return &r.Status
+}
+
+// GetTemplate returns a populated LogAccessTemplate that this LogAccessRequest is referencing.
+func (r *LogAccessRequest) GetTemplate(
+	ctx context.Context,
+	cl client.Client,
+) (ITemplateResource, error) {
+	return GetLogAccessTemplate(ctx, cl, r.Spec.TemplateName, r.Namespace)
+}
+
+// GetTemplateName returns the user supplied Spec.templateName field
+func (r *LogAccessRequest) GetTemplateName() string {
+	return r.Spec.TemplateName
+}
+
+// GetDuration conforms to the interfaces.OzRequestResource interface
+func (r *LogAccessRequest) GetDuration() (time.Duration, error) {
+	if r.Spec.Duration != "" {
+		return time.ParseDuration(r.Spec.Duration)
+	}
+	return time.Duration(0), nil
+}
+
+// GetStartTime conforms to the interfaces.OzRequestResource interface. A
+// Spec.startTime before the creation of the request is ignored, so that the
+// access can never be backdated.
+func (r *LogAccessRequest) GetStartTime() time.Time {
+	if r.Spec.StartTime != nil && r.Spec.StartTime.After(r.CreationTimestamp.Time) {
+		return r.Spec.StartTime.Time
+	}
+	return r.CreationTimestamp.Time
+}
+
+// GetUptime conforms to the interfaces.OzRequestResource interface
+func (r *LogAccessRequest) GetUptime() time.Duration {
+	return time.Since(r.GetStartTime())
+}
+
+// GetRequestedBy conforms to the interfaces.OzRequestResource interface
+func (r *LogAccessRequest) GetRequestedBy() *RequesterInfo {
+	return r.Spec.RequestedBy
+}
+
+// IsRevoked conforms to the interfaces.OzRequestResource interface
+func (r *LogAccessRequest) IsRevoked() bool {
+	return r.Spec.Revoked
+}
+
+// GetRevokedBy conforms to the interfaces.OzRequestResource interface
+func (r *LogAccessRequest) GetRevokedBy() *RequesterInfo {
+	return r.Spec.RevokedBy
+}
+
+// SetRevokedBy conforms to the interfaces.OzRequestResource interface
+func (r *LogAccessRequest) SetRevokedBy(info *RequesterInfo) {
+	r.Spec.RevokedBy = info
+}
+
+//+kubebuilder:object:root=true
+
+// LogAccessRequestList contains a list of LogAccessRequest
+type LogAccessRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogAccessRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LogAccessRequest{}, &LogAccessRequestList{})
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2022 Matt Wise.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LogAccessTemplateSpec defines the desired state of LogAccessTemplate
+type LogAccessTemplateSpec struct {
+	// AccessConfig provides a common struct for defining who has access to the resources this
+	// template controls, how long they have access, etc.
+	//
+	// The AccessConfig.accessCommand setting is not used by this template -
+	// the access message is always a "kubectl logs -l ..." command for the
+	// Pods of the target controller.
+	AccessConfig AccessConfig `json:"accessConfig"`
+
+	// ControllerTargetRef provides a pattern for referencing objects from another API in a generic way.
+	//
+	// +kubebuilder:validation:Required
+	ControllerTargetRef *CrossVersionObjectReference `json:"controllerTargetRef"`
+
+	// AllowPodListing additionally grants "list" on Pods, so that the
+	// requester can use "kubectl logs -l <selector>" to find the Pods of the
+	// target controller. Kubernetes RBAC cannot limit a "list" to specific
+	// Pods, so this exposes every Pod in the namespace (including their
+	// specs, but not their logs) to the requester.
+	//
+	// If omitted, only the Pods of the target controller can be read - by
+	// name. Their names are recorded in the Status.podNames of the request.
+	//
+	// +kubebuilder:default:=false
+	AllowPodListing bool `json:"allowPodListing,omitempty"`
+}
+
+// LogAccessTemplateStatus defines the observed state of LogAccessTemplate
+type LogAccessTemplateStatus struct {
+	CoreStatus    `json:",inline"`
+	TemplateUsage `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// LogAccessTemplate is the Schema for the logaccesstemplates API
+//
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Is template ready?"
+// +kubebuilder:printcolumn:name="Active",type="integer",JSONPath=".status.activeRequestCount",description="Number of active Access Requests"
+// +kubebuilder:printcolumn:name="Used",type="integer",JSONPath=".status.usageCount",description="Number of Access Requests made over the lifetime of the template"
+// +kubebuilder:printcolumn:name="Last Used",type="date",JSONPath=".status.lastUsedTime",description="Time of the most recent Access Request"
+type LogAccessTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogAccessTemplateSpec   `json:"spec,omitempty"`
+	Status LogAccessTemplateStatus `json:"status,omitempty"`
+}
+
+// https://stackoverflow.com/questions/33089523/how-to-mark-golang-struct-as-implementing-interface
+var (
+	_ ITemplateResource = &LogAccessTemplate{}
+	_ ITemplateResource = (*LogAccessTemplate)(nil)
+)
+
+// GetStatus returns the core Status field for this resource.
+func (t *LogAccessTemplate) GetStatus() ICoreStatus {
+	return &t.Status
+}
+
+// GetAccessConfig returns the Spec.accessConfig field for this resource in an AccessConfig object form.
+func (t *LogAccessTemplate) GetAccessConfig() *AccessConfig {
+	return &t.Spec.AccessConfig
+}
+
+// GetTargetRef conforms to the controllers.OzTemplateResource interface.
+func (t *LogAccessTemplate) GetTargetRef() *CrossVersionObjectReference {
+	return t.Spec.ControllerTargetRef
+}
+
+// GetLogAccessTemplate returns back a LogAccessTemplate
+// resource matching the supplied name and namespace, or returns back an
+// error.
+func GetLogAccessTemplate(
+	ctx context.Context,
+	cl client.Reader,
+	name string,
+	namespace string,
+) (*LogAccessTemplate, error) {
+	tmpl := &LogAccessTemplate{}
+	err := cl.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, tmpl)
+	return tmpl, err
+}
+
+//+kubebuilder:object:root=true
+
+// LogAccessTemplateList contains a list of LogAccessTemplate
+type LogAccessTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogAccessTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LogAccessTemplate{}, &LogAccessTemplateList{})
+}
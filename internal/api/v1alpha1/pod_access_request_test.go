@@ -13,6 +13,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -133,6 +134,8 @@ var _ = Describe("PodAccessRequest", Ordered, func() {
 		// package so that we can write one set of tests for all of the
 		// Validate* functions.
 		It("Create with UserInfo...", func() {
+			request.Namespace = template.Namespace
+			request.Spec.TemplateName = template.Name
 			requestBytes, _ := json.Marshal(request)
 			admissionRequest = &admission.Request{
 				AdmissionRequest: admissionv1.AdmissionRequest{
@@ -145,7 +148,7 @@ var _ = Describe("PodAccessRequest", Ordered, func() {
 					UserInfo: authenticationv1.UserInfo{
 						Username: "admin",
 						UID:      "",
-						Groups:   []string{},
+						Groups:   []string{"admins"},
 						Extra: map[string]authenticationv1.ExtraValue{
 							"": {},
 						},
@@ -155,11 +158,46 @@ var _ = Describe("PodAccessRequest", Ordered, func() {
 					},
 				},
 			}
-			_, err = request.ValidateCreate(*admissionRequest)
+			_, err = request.ValidateCreate(webhookCtx, *admissionRequest)
 			Expect(err).To(Not(HaveOccurred()))
 		})
 
+		It("Create with UserInfo outside of the AllowedGroups...", func() {
+			request.Namespace = template.Namespace
+			request.Spec.TemplateName = template.Name
+			requestBytes, _ := json.Marshal(request)
+			admissionRequest = &admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Resource:        gvr,
+					RequestKind:     &gvk,
+					RequestResource: &gvr,
+					Name:            requestName,
+					Namespace:       namespace.Name,
+					Operation:       "CREATE",
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						UID:      "",
+						Groups:   []string{"others"},
+						Extra: map[string]authenticationv1.ExtraValue{
+							"": {},
+						},
+					},
+					Object: runtime.RawExtension{
+						Raw: requestBytes,
+					},
+				},
+			}
+			_, err = request.ValidateCreate(webhookCtx, *admissionRequest)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not a member of any of the allowedGroups"))
+		})
+
 		It("Create without UserInfo...", func() {
+			// Point the request at the real template, so that the request
+			// is denied because of the empty groups - not because the
+			// template lookup fails.
+			request.Namespace = template.Namespace
+			request.Spec.TemplateName = template.Name
 			requestBytes, _ := json.Marshal(request)
 			admissionRequest = &admission.Request{
 				AdmissionRequest: admissionv1.AdmissionRequest{
@@ -175,8 +213,9 @@ var _ = Describe("PodAccessRequest", Ordered, func() {
 					},
 				},
 			}
-			_, err = request.ValidateCreate(*admissionRequest)
-			Expect(err).To(Not(HaveOccurred()))
+			_, err = request.ValidateCreate(webhookCtx, *admissionRequest)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not a member of any of the allowedGroups"))
 		})
 
 		It("Update with UserInfo...", func() {
@@ -202,7 +241,7 @@ var _ = Describe("PodAccessRequest", Ordered, func() {
 					},
 				},
 			}
-			_, err = request.ValidateUpdate(*admissionRequest, request)
+			_, err = request.ValidateUpdate(webhookCtx, *admissionRequest, request)
 			Expect(err).To(Not(HaveOccurred()))
 		})
 
@@ -222,9 +261,99 @@ var _ = Describe("PodAccessRequest", Ordered, func() {
 					},
 				},
 			}
-			_, err = request.ValidateUpdate(*admissionRequest, request)
+			_, err = request.ValidateUpdate(webhookCtx, *admissionRequest, request)
+			Expect(err).To(Not(HaveOccurred()))
+		})
+
+		It("Default() should record the requester on create...", func() {
+			req := request.DeepCopy()
+			req.Spec.RequestedBy = &RequesterInfo{Username: "forged"}
+			err = req.Default(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						UID:      "1234",
+						Groups:   []string{"admins"},
+					},
+				},
+			})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(req.GetRequestedBy()).To(Equal(&RequesterInfo{
+				Username: "admin",
+				UID:      "1234",
+				Groups:   []string{"admins"},
+			}))
+		})
+
+		It("Default() should not touch the requester on update...", func() {
+			req := request.DeepCopy()
+			req.Spec.RequestedBy = &RequesterInfo{Username: "admin"}
+			err = req.Default(webhookCtx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Update,
+					UserInfo:  authenticationv1.UserInfo{Username: "other"},
+				},
+			})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(req.GetRequestedBy().Username).To(Equal("admin"))
+		})
+
+		It("ValidateUpdate() should reject changes to Spec.RequestedBy...", func() {
+			oldReq := request.DeepCopy()
+			oldReq.Spec.RequestedBy = &RequesterInfo{Username: "admin"}
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.RequestedBy.Username = "other"
+			_, err = newReq.ValidateUpdate(webhookCtx, admission.Request{}, oldReq)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("ValidateCreate() should enforce the template resource maximums...", func() {
+			req := request.DeepCopy()
+			req.Namespace = template.Namespace
+			req.Spec.TemplateName = template.Name
+			req.Spec.Resources = &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("2"),
+				},
+			}
+			admissionRequest := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						Groups:   []string{"admins"},
+					},
+				},
+			}
+
+			// VERIFY: The template does not set a maxCpu yet
+			_, err = req.ValidateCreate(webhookCtx, admissionRequest)
+			Expect(err).To(MatchError(ContainSubstring("does not set a maximum")))
+
+			// VERIFY: Above the maxCpu is rejected
+			template.Spec.MaxCPU = resource.MustParse("1")
+			Expect(k8sClient.Update(ctx, template)).To(Succeed())
+			_, err = req.ValidateCreate(webhookCtx, admissionRequest)
+			Expect(err).To(MatchError(ContainSubstring("is above the maximum")))
+
+			// VERIFY: At or below the maxCpu is allowed
+			req.Spec.Resources.Requests[corev1.ResourceCPU] = resource.MustParse("1")
+			_, err = req.ValidateCreate(webhookCtx, admissionRequest)
 			Expect(err).To(Not(HaveOccurred()))
 		})
+
+		It("ValidateUpdate() should reject changes to Spec.Resources...", func() {
+			oldReq := request.DeepCopy()
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.Resources = &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("1"),
+				},
+			}
+			_, err = newReq.ValidateUpdate(webhookCtx, admission.Request{}, oldReq)
+			Expect(err).To(MatchError(ContainSubstring("Spec.Resources is an immutable field")))
+		})
 	})
 
 	// Setup code below here - this code rarely changes, the tests above are
@@ -292,7 +421,9 @@ var _ = Describe("PodAccessRequest", Ordered, func() {
 			},
 			Spec: PodAccessTemplateSpec{
 				AccessConfig: AccessConfig{
-					AllowedGroups:   []string{"admins"},
+					// system:masters is the group of the envtest user that
+					// creates the requests in the Reconciliation tests above.
+					AllowedGroups:   []string{"admins", "system:masters"},
 					DefaultDuration: "1h",
 					MaxDuration:     "24h",
 				},
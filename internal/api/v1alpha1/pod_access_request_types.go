@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,8 +38,9 @@ type PodAccessRequestSpec struct {
 	// +kubebuilder:validation:Required
 	TemplateName string `json:"templateName"`
 
-	// Duration sets the length of time from the `spec.creationTimestamp` that this object will live. After the
-	// time has expired, the resouce will be automatically deleted on the next reconcilliation loop.
+	// Duration sets the length of time from the `spec.startTime` (or the `metadata.creationTimestamp`
+	// if no start time is set) that this object will live. After the time has expired, the resouce
+	// will be automatically deleted on the next reconcilliation loop.
 	//
 	// If omitted, the spec.defautlDuration from the ExecAccessTemplate is used.
 	//
@@ -47,6 +49,47 @@ type PodAccessRequestSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Pattern="^[0-9]+(s|m|h)$"
 	Duration string `json:"duration,omitempty"`
+
+	// StartTime schedules the access to begin at a later time. Until then,
+	// the request is kept in a Scheduled state and no access resources are
+	// created. The Spec.duration is measured from this time, and it cannot
+	// be changed after creation. It cannot be in the past - access cannot
+	// be backdated.
+	//
+	// If omitted, the access begins as soon as the request is created.
+	//
+	// +kubebuilder:validation:Optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// RequestedBy records the identity of the user that created this
+	// request. This field is set by the Oz admission webhook - any value
+	// supplied by the user is overwritten, and it cannot be changed after
+	// creation.
+	//
+	// +kubebuilder:validation:Optional
+	RequestedBy *RequesterInfo `json:"requestedBy,omitempty"`
+
+	// Revoked ends the access granted by this request early. Once set it
+	// cannot be unset, and the request is deleted on the next reconciliation
+	// loop.
+	//
+	// +kubebuilder:validation:Optional
+	Revoked bool `json:"revoked,omitempty"`
+
+	// RevokedBy records the identity of the user that revoked this request.
+	// This field is set by the Oz admission webhook - any value supplied by
+	// the user is overwritten.
+	//
+	// +kubebuilder:validation:Optional
+	RevokedBy *RequesterInfo `json:"revokedBy,omitempty"`
+
+	// Resources overrides the CPU, memory and ephemeral storage requests and
+	// limits of the default container in the Pod. Each value must be at or
+	// below the maxCpu, maxMemory and maxStorage settings of the
+	// PodAccessTemplate. This field cannot be changed after creation.
+	//
+	// +kubebuilder:validation:Optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 // PodAccessRequestStatus defines the observed state of AccessRequest
@@ -55,6 +98,11 @@ type PodAccessRequestStatus struct {
 
 	// The Target Pod Name where access has been granted
 	PodName string `json:"podName,omitempty"`
+
+	// Resources records the resources of the default container in the Pod,
+	// after the spec.resources of the request have been applied. Only set if
+	// spec.resources was supplied.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -63,8 +111,11 @@ type PodAccessRequestStatus struct {
 // PodAccessRequest is the Schema for the accessrequests API
 //
 // +kubebuilder:printcolumn:name="Template",type="string",JSONPath=".spec.templateName",description="Access Template"
+// +kubebuilder:printcolumn:name="User",type="string",JSONPath=".spec.requestedBy.username",description="Requesting User"
 // +kubebuilder:printcolumn:name="Pod",type="string",JSONPath=".status.podName",description="Target Pod Name"
 // +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Is request ready?"
+// +kubebuilder:printcolumn:name="Starts",type="string",format="date-time",JSONPath=".spec.startTime",description="When the access starts",priority=1
+// +kubebuilder:printcolumn:name="Expires",type="string",format="date-time",JSONPath=".status.expiresAt",description="When the access expires"
 type PodAccessRequest struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -109,11 +160,39 @@ func (r *PodAccessRequest) GetDuration() (time.Duration, error) {
 	return time.Duration(0), nil
 }
 
-// GetUptime conform to the interfaces.OzRequestResource interface
+// GetStartTime conforms to the interfaces.OzRequestResource interface. A
+// Spec.startTime before the creation of the request is ignored, so that the
+// access can never be backdated.
+func (r *PodAccessRequest) GetStartTime() time.Time {
+	if r.Spec.StartTime != nil && r.Spec.StartTime.After(r.CreationTimestamp.Time) {
+		return r.Spec.StartTime.Time
+	}
+	return r.CreationTimestamp.Time
+}
+
+// GetUptime conforms to the interfaces.OzRequestResource interface
 func (r *PodAccessRequest) GetUptime() time.Duration {
-	now := time.Now()
-	creation := r.CreationTimestamp.Time
-	return now.Sub(creation)
+	return time.Since(r.GetStartTime())
+}
+
+// GetRequestedBy conforms to the interfaces.OzRequestResource interface
+func (r *PodAccessRequest) GetRequestedBy() *RequesterInfo {
+	return r.Spec.RequestedBy
+}
+
+// IsRevoked conforms to the interfaces.OzRequestResource interface
+func (r *PodAccessRequest) IsRevoked() bool {
+	return r.Spec.Revoked
+}
+
+// GetRevokedBy conforms to the interfaces.OzRequestResource interface
+func (r *PodAccessRequest) GetRevokedBy() *RequesterInfo {
+	return r.Spec.RevokedBy
+}
+
+// SetRevokedBy conforms to the interfaces.OzRequestResource interface
+func (r *PodAccessRequest) SetRevokedBy(info *RequesterInfo) {
+	r.Spec.RevokedBy = info
 }
 
 // SetPodName conforms to the interfaces.OzRequestResource interface
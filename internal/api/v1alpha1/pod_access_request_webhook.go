@@ -17,8 +17,12 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -50,17 +54,25 @@ func (r *PodAccessRequest) SetupWebhookWithManager(mgr ctrl.Manager) error {
 
 var _ webhook.IContextuallyDefaultableObject = &PodAccessRequest{}
 
-// Default implements webhook.Defaulter so a webhook will be registered for the type
-func (r *PodAccessRequest) Default(_ admission.Request) error {
-	return nil
+// Default records the identity of the user creating the PodAccessRequest into
+// Spec.requestedBy. Any value supplied by the user is overwritten.
+//
+// The user that revokes the request is recorded into Spec.revokedBy in the
+// same way.
+func (r *PodAccessRequest) Default(_ context.Context, req admission.Request) error {
+	if req.Operation == admissionv1.Create {
+		r.Spec.RequestedBy = NewRequesterInfo(req.UserInfo)
+	}
+	return defaultRevokedBy(req, r, &PodAccessRequest{})
 }
 
 //+kubebuilder:webhook:path=/validate-crds-wizardofoz-co-v1alpha1-podaccessrequest,mutating=false,failurePolicy=fail,sideEffects=None,groups=crds.wizardofoz.co,resources=podaccessrequests,verbs=create;update;delete,versions=v1alpha1,name=vpodaccessrequest.kb.io,admissionReviewVersions=v1
 
 var _ webhook.IContextuallyValidatableObject = &PodAccessRequest{}
 
-// ValidateCreate implements webhook.IContextuallyValidatableObject so a webhook will be registered for the type
-func (r *PodAccessRequest) ValidateCreate(req admission.Request) (admission.Warnings, error) {
+// ValidateCreate verifies that the requesting user is allowed to use the
+// referenced PodAccessTemplate, within its concurrency limits and allowed windows.
+func (r *PodAccessRequest) ValidateCreate(ctx context.Context, req admission.Request) (admission.Warnings, error) {
 	warnings := admission.Warnings{}
 	if req.UserInfo.Username != "" {
 		podaccessrequestlog.Info(
@@ -72,11 +84,53 @@ func (r *PodAccessRequest) ValidateCreate(req admission.Request) (admission.Warn
 		warnings = append(warnings, w)
 		podaccessrequestlog.Info(w)
 	}
+
+	// Every check below is made against the same Access Template.
+	tmpl, err := getRequestTemplate(ctx, r)
+	if err != nil {
+		return warnings, err
+	}
+
+	// Only members of the template's AllowedGroups may request access
+	// through it - otherwise we would create resources that the requester
+	// cannot even use.
+	if err := verifyRequesterAllowed(tmpl, req.UserInfo); err != nil {
+		return warnings, err
+	}
+
+	// The template may limit how many requests can be live at once.
+	if err := verifyConcurrencyLimits(ctx, r, tmpl, req.UserInfo); err != nil {
+		return warnings, err
+	}
+
+	// Access can be scheduled for later, but never backdated.
+	if err := verifyStartTime(r.Spec.StartTime, time.Now()); err != nil {
+		return warnings, err
+	}
+
+	// The template may only be usable at certain times.
+	if err := verifyAllowedWindows(r, tmpl); err != nil {
+		return warnings, err
+	}
+
+	// Any requested resources must be within the template's maximums.
+	if err := r.verifyResourcesAllowed(tmpl.(*PodAccessTemplate)); err != nil {
+		return warnings, err
+	}
 	return warnings, nil
 }
 
-// ValidateUpdate implements webhook.IContextuallyValidatableObject so a webhook will be registered for the type
-func (r *PodAccessRequest) ValidateUpdate(req admission.Request, _ runtime.Object) (admission.Warnings, error) {
+// verifyResourcesAllowed verifies that Spec.resources is within the maximums
+// of the PodAccessTemplate referenced by the request.
+func (r *PodAccessRequest) verifyResourcesAllowed(tmpl *PodAccessTemplate) error {
+	if r.Spec.Resources == nil {
+		return nil
+	}
+	return tmpl.VerifyResources(*r.Spec.Resources)
+}
+
+// ValidateUpdate prevents immutable updates to the PodAccessRequest.
+func (r *PodAccessRequest) ValidateUpdate(ctx context.Context, req admission.Request, old runtime.Object) (admission.Warnings, error) {
 	warnings := admission.Warnings{}
 	if req.UserInfo.Username != "" {
 		podaccessrequestlog.Info(
@@ -88,11 +142,46 @@ func (r *PodAccessRequest) ValidateUpdate(req admission.Request, _ runtime.Objec
 		warnings = append(warnings, w)
 		podaccessrequestlog.Info(w)
 	}
+
+	// https://stackoverflow.com/questions/70650677/manage-immutable-fields-in-kubebuilder-validating-webhook
+	oldRequest, _ := old.(*PodAccessRequest)
+	if !equality.Semantic.DeepEqual(r.Spec.RequestedBy, oldRequest.Spec.RequestedBy) {
+		return warnings, fmt.Errorf(
+			"error - Spec.RequestedBy is an immutable field, create a new PodAccessRequest instead",
+		)
+	}
+	if !equality.Semantic.DeepEqual(r.Spec.StartTime, oldRequest.Spec.StartTime) {
+		return warnings, fmt.Errorf(
+			"error - Spec.StartTime is an immutable field, create a new PodAccessRequest instead",
+		)
+	}
+	if !equality.Semantic.DeepEqual(r.Spec.Resources, oldRequest.Spec.Resources) {
+		return warnings, fmt.Errorf(
+			"error - Spec.Resources is an immutable field, create a new PodAccessRequest instead",
+		)
+	}
+
+	// Anyone allowed to update the request may revoke it. The revoking user
+	// has already been recorded in Spec.revokedBy by the mutating webhook.
+	if err := verifyRevocationUpdate(r, oldRequest, req.UserInfo); err != nil {
+		return warnings, err
+	}
+	if r.Spec.Revoked && !oldRequest.Spec.Revoked {
+		podaccessrequestlog.Info(
+			fmt.Sprintf("Revoke PodAccessRequest %s from %s", r.Name, req.UserInfo.Username),
+		)
+	}
+
+	// Spec.duration may be changed to extend (or shorten) the access, within
+	// the limits of the template.
+	if err := verifyDurationUpdate(ctx, r, oldRequest, req.UserInfo); err != nil {
+		return warnings, err
+	}
 	return warnings, nil
 }
 
 // ValidateDelete implements webhook.IContextuallyValidatableObject so a webhook will be registered for the type
-func (r *PodAccessRequest) ValidateDelete(req admission.Request) (admission.Warnings, error) {
+func (r *PodAccessRequest) ValidateDelete(_ context.Context, req admission.Request) (admission.Warnings, error) {
 	podaccessrequestlog.Info(
 		fmt.Sprintf("Delete PodAccessRequest from %s", req.UserInfo.Username),
 	)
@@ -0,0 +1,198 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("PodAccessTemplate", func() {
+	var template *PodAccessTemplate
+
+	BeforeEach(func() {
+		template = &PodAccessTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "default",
+			},
+			Spec: PodAccessTemplateSpec{
+				AccessConfig: AccessConfig{
+					AllowedGroups:   []string{"admins"},
+					DefaultDuration: "1h",
+					MaxDuration:     "24h",
+				},
+			},
+		}
+	})
+
+	targetRef := func() *CrossVersionObjectReference {
+		return &CrossVersionObjectReference{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       "test-deployment",
+		}
+	}
+
+	podSpec := func() *corev1.PodSpec {
+		return &corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "shell", Image: "busybox:latest"},
+			},
+		}
+	}
+
+	It("Validate() should accept a controllerTargetRef", func() {
+		template.Spec.ControllerTargetRef = targetRef()
+		template.Spec.ControllerTargetMutationConfig = &PodTemplateSpecMutationConfig{}
+		Expect(template.Validate()).To(Succeed())
+	})
+
+	It("Validate() should accept a standalone podSpec", func() {
+		template.Spec.PodSpec = podSpec()
+		Expect(template.Validate()).To(Succeed())
+	})
+
+	It("Validate() should reject both controllerTargetRef and podSpec", func() {
+		template.Spec.ControllerTargetRef = targetRef()
+		template.Spec.PodSpec = podSpec()
+		Expect(template.Validate()).To(MatchError(ContainSubstring("cannot set both")))
+	})
+
+	It("Validate() should reject neither controllerTargetRef nor podSpec", func() {
+		Expect(template.Validate()).To(MatchError(ContainSubstring("must be set")))
+
+		// An empty controllerTargetRef is no different than a missing one
+		template.Spec.ControllerTargetRef = &CrossVersionObjectReference{}
+		Expect(template.Validate()).To(MatchError(ContainSubstring("must be set")))
+	})
+
+	It("Validate() should reject a podSpec without any containers", func() {
+		template.Spec.PodSpec = &corev1.PodSpec{}
+		Expect(template.Validate()).To(MatchError(ContainSubstring("at least one container")))
+	})
+
+	It("Validate() should reject a controllerTargetMutationConfig alongside a podSpec", func() {
+		template.Spec.PodSpec = podSpec()
+		template.Spec.ControllerTargetMutationConfig = &PodTemplateSpecMutationConfig{}
+		Expect(template.Validate()).To(
+			MatchError(ContainSubstring("controllerTargetMutationConfig")),
+		)
+	})
+
+	It("Validate() should reject invalid durations", func() {
+		template.Spec.PodSpec = podSpec()
+
+		template.Spec.AccessConfig.DefaultDuration = "1 hour"
+		Expect(template.Validate()).To(
+			MatchError(ContainSubstring("invalid spec.accessConfig.defaultDuration")),
+		)
+
+		template.Spec.AccessConfig.DefaultDuration = "48h"
+		Expect(template.Validate()).To(
+			MatchError(ContainSubstring("can not be greater than spec.accessConfig.maxDuration")),
+		)
+	})
+
+	It("Validate() should reject unsupported controllerTargetRefs", func() {
+		template.Spec.ControllerTargetRef = targetRef()
+		template.Spec.ControllerTargetRef.Kind = "CronJob"
+		Expect(template.Validate()).To(MatchError(ContainSubstring("is not supported")))
+
+		template.Spec.ControllerTargetRef = targetRef()
+		template.Spec.ControllerTargetRef.APIVersion = "v1"
+		Expect(template.Validate()).To(MatchError(ContainSubstring("group/version")))
+
+		template.Spec.ControllerTargetRef = targetRef()
+		template.Spec.ControllerTargetRef.APIVersion = "argoproj.io/v1alpha1"
+		Expect(template.Validate()).To(MatchError(ContainSubstring("must be apps/v1")))
+	})
+
+	It("Validate() should reject patchSpecOperations that can not apply", func() {
+		template.Spec.ControllerTargetRef = targetRef()
+		template.Spec.ControllerTargetMutationConfig = &PodTemplateSpecMutationConfig{
+			PatchSpecOperations: []JSONPatchOperation{
+				{Operation: "replace", Path: "/spec/containers/0/image", Value: intstr.FromString("busybox")},
+				{Operation: "add", Path: "/metadata/labels/foo", Value: intstr.FromString("bar")},
+				{Operation: "remove", Path: "/spec/tolerations/-"},
+			},
+		}
+		Expect(template.Validate()).To(Succeed())
+
+		template.Spec.ControllerTargetMutationConfig.PatchSpecOperations[1].Path = "/spec/containrs/0"
+		Expect(template.Validate()).To(
+			MatchError(ContainSubstring("patchSpecOperations[1]: path \"/spec/containrs/0\" is invalid")),
+		)
+
+		template.Spec.ControllerTargetMutationConfig.PatchSpecOperations[1].Path = "/spec/containers/first"
+		Expect(template.Validate()).To(MatchError(ContainSubstring("is not a list index")))
+
+		template.Spec.ControllerTargetMutationConfig.PatchSpecOperations[1] = JSONPatchOperation{
+			Operation: "move",
+			Path:      "/spec/hostname",
+		}
+		Expect(template.Validate()).To(MatchError(ContainSubstring("needs a \"from\" path")))
+	})
+
+	It("ValidateCreate() and ValidateUpdate() should call Validate()", func() {
+		_, err := template.ValidateCreate(webhookCtx, admission.Request{})
+		Expect(err).To(HaveOccurred())
+		_, err = template.ValidateUpdate(webhookCtx, admission.Request{}, template.DeepCopy())
+		Expect(err).To(HaveOccurred())
+
+		template.Spec.PodSpec = podSpec()
+		_, err = template.ValidateCreate(webhookCtx, admission.Request{})
+		Expect(err).To(Not(HaveOccurred()))
+		_, err = template.ValidateUpdate(webhookCtx, admission.Request{}, template.DeepCopy())
+		Expect(err).To(Not(HaveOccurred()))
+	})
+
+	It("VerifyResources() should enforce the maximums", func() {
+		template.Spec.MaxCPU = resource.MustParse("2")
+		template.Spec.MaxMemory = resource.MustParse("1Gi")
+
+		// VERIFY: Within the maximums
+		Expect(template.VerifyResources(corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			},
+		})).To(Succeed())
+
+		// VERIFY: Above a maximum
+		Expect(template.VerifyResources(corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("2Gi"),
+			},
+		})).To(MatchError(ContainSubstring("spec.resources.limits.memory (2Gi) is above the maximum (1Gi)")))
+
+		// VERIFY: No maximum set
+		Expect(template.VerifyResources(corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+			},
+		})).To(MatchError(ContainSubstring("does not set a maximum")))
+
+		// VERIFY: Unsupported resource
+		Expect(template.VerifyResources(corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				"nvidia.com/gpu": resource.MustParse("1"),
+			},
+		})).To(MatchError(ContainSubstring("only cpu, memory and ephemeral-storage are supported")))
+	})
+
+	It("should be rejected by the webhook when invalid", func() {
+		template.Namespace = "default"
+		template.Name = "invalid-pod-access-template"
+		err := k8sClient.Create(ctx, template)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("must be set"))
+	})
+})
@@ -19,7 +19,9 @@ package v1alpha1
 import (
 	"context"
 	"errors"
-	"reflect"
+	"fmt"
+	"maps"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -35,9 +37,12 @@ type PodAccessTemplateSpec struct {
 	AccessConfig AccessConfig `json:"accessConfig"`
 
 	// ControllerTargetRef provides a pattern for referencing objects from another API in a generic way.
+	// The Pods for each Access Request are copied from the PodTemplateSpec of
+	// this controller. Exactly one of controllerTargetRef or podSpec must be
+	// set.
 	//
 	// +kubebuilder:validation:Optional
-	ControllerTargetRef *CrossVersionObjectReference `json:"controllerTargetRef"`
+	ControllerTargetRef *CrossVersionObjectReference `json:"controllerTargetRef,omitempty"`
 
 	// ControllerTargetMutationConfig contains parameters that allow for customizing the copy of a
 	// controller-sourced PodSpec. This setting is only valid if controllerTargetRef is set.
@@ -45,7 +50,16 @@ type PodAccessTemplateSpec struct {
 	// +kubebuilder:validation:Optional
 	ControllerTargetMutationConfig *PodTemplateSpecMutationConfig `json:"controllerTargetMutationConfig,omitempty"`
 
-	// PodSpec ...
+	// AccessRules optionally replaces the default permissions (read access
+	// to the target Pod, plus "pods/exec") that are granted by each Access
+	// Request. Rules are always scoped to the target Pod of the request.
+	//
+	// +kubebuilder:validation:Optional
+	AccessRules []AccessRule `json:"accessRules,omitempty"`
+
+	// PodSpec is used to launch a completely custom Pod for each Access
+	// Request, rather than copying the PodTemplateSpec of an existing
+	// controller. Exactly one of controllerTargetRef or podSpec must be set.
 	//
 	// +kubebuilder:validation:Optional
 	PodSpec *corev1.PodSpec `json:"podSpec,omitempty"`
@@ -69,7 +83,8 @@ type PodAccessTemplateSpec struct {
 
 // PodAccessTemplateStatus defines the observed state of PodAccessTemplate
 type PodAccessTemplateStatus struct {
-	CoreStatus `json:",inline"`
+	CoreStatus    `json:",inline"`
+	TemplateUsage `json:",inline"`
 }
 
 //+kubebuilder:object:root=true
@@ -79,6 +94,9 @@ type PodAccessTemplateStatus struct {
 //
 // +kubebuilder:object:root=true
 // +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Is template ready?"
+// +kubebuilder:printcolumn:name="Active",type="integer",JSONPath=".status.activeRequestCount",description="Number of active Access Requests"
+// +kubebuilder:printcolumn:name="Used",type="integer",JSONPath=".status.usageCount",description="Number of Access Requests made over the lifetime of the template"
+// +kubebuilder:printcolumn:name="Last Used",type="date",JSONPath=".status.lastUsedTime",description="Time of the most recent Access Request"
 type PodAccessTemplate struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -112,22 +130,98 @@ func (t *PodAccessTemplate) GetAccessConfig() *AccessConfig {
 	return &t.Spec.AccessConfig
 }
 
-// Validate the inputs
+// GetAccessRules returns the Spec.accessRules field, or the
+// DefaultAccessRules if none are set.
+func (t *PodAccessTemplate) GetAccessRules() []AccessRule {
+	if len(t.Spec.AccessRules) == 0 {
+		return DefaultAccessRules()
+	}
+	return t.Spec.AccessRules
+}
+
+// Validate the inputs. Exactly one of Spec.controllerTargetRef and
+// Spec.podSpec must be set, and Spec.controllerTargetMutationConfig may only
+// be used alongside Spec.controllerTargetRef. The durations, the
+// controllerTargetRef, the accessRules and the JSON patch operations of the
+// controllerTargetMutationConfig must all be valid too.
 func (t *PodAccessTemplate) Validate() error {
-	if (*t.Spec.ControllerTargetRef != CrossVersionObjectReference{}) &&
-		reflect.DeepEqual(t.Spec.PodSpec, corev1.PodSpec{}) {
+	hasTargetRef := hasTargetRef(t)
+	hasPodSpec := t.Spec.PodSpec != nil
+
+	if hasTargetRef && hasPodSpec {
 		return errors.New(
 			"cannot set both Spec.controllerTargetRef and spec.podSpec - use one or the other",
 		)
 	}
 
-	if (*t.Spec.ControllerTargetRef == CrossVersionObjectReference{}) &&
-		reflect.DeepEqual(t.Spec.ControllerTargetMutationConfig, PodTemplateSpecMutationConfig{}) {
+	if !hasTargetRef && !hasPodSpec {
+		return errors.New(
+			"one of Spec.controllerTargetRef or Spec.podSpec must be set",
+		)
+	}
+
+	if hasPodSpec && len(t.Spec.PodSpec.Containers) == 0 {
+		return errors.New("Spec.podSpec must define at least one container")
+	}
+
+	if !hasTargetRef && t.Spec.ControllerTargetMutationConfig != nil {
 		return errors.New(
 			"cannot set Spec.controllerTargetMutationConfig if Spec.controllerTargetRef is not also set",
 		)
 	}
 
+	if mutator := t.Spec.ControllerTargetMutationConfig; mutator != nil {
+		if err := mutator.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return validateTemplate(t, t.Spec.AccessRules)
+}
+
+// VerifyResources returns an error if any of the supplied resources are above
+// the Spec.maxCpu, Spec.maxMemory or Spec.maxStorage limits of the template.
+// Resources that the template does not set a maximum for cannot be requested
+// at all.
+func (t *PodAccessTemplate) VerifyResources(res corev1.ResourceRequirements) error {
+	maximums := map[corev1.ResourceName]resource.Quantity{
+		corev1.ResourceCPU:              t.Spec.MaxCPU,
+		corev1.ResourceMemory:           t.Spec.MaxMemory,
+		corev1.ResourceEphemeralStorage: t.Spec.MaxStorage,
+	}
+
+	for _, f := range []struct {
+		field string
+		list  corev1.ResourceList
+	}{
+		{"requests", res.Requests},
+		{"limits", res.Limits},
+	} {
+		field := f.field
+		for _, name := range slices.Sorted(maps.Keys(f.list)) {
+			qty := f.list[name]
+			maximum, ok := maximums[name]
+			if !ok {
+				return fmt.Errorf(
+					"spec.resources.%s.%s cannot be requested, only %s, %s and %s are supported",
+					field, name, corev1.ResourceCPU, corev1.ResourceMemory,
+					corev1.ResourceEphemeralStorage,
+				)
+			}
+			if maximum.IsZero() {
+				return fmt.Errorf(
+					"spec.resources.%s.%s cannot be requested, PodAccessTemplate %s does not set a maximum",
+					field, name, t.GetName(),
+				)
+			}
+			if qty.Cmp(maximum) > 0 {
+				return fmt.Errorf(
+					"spec.resources.%s.%s (%s) is above the maximum (%s) of PodAccessTemplate %s",
+					field, name, qty.String(), maximum.String(), t.GetName(),
+				)
+			}
+		}
+	}
 	return nil
 }
 
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	corev1 "k8s.io/api/core/v1"
@@ -152,6 +154,92 @@ type JSONPatchOperation struct {
 	Value intstr.IntOrString `json:"value,omitempty"`
 }
 
+// Validate verifies that the operation can be applied to a PodTemplateSpec -
+// that it is a supported operation, and that its path refers to a field that
+// exists in the PodTemplateSpec schema. Whether the path exists in a specific
+// PodTemplateSpec can only be known when it is applied.
+func (o JSONPatchOperation) Validate() error {
+	switch o.Operation {
+	case JSONPatchOperationTypeAdd, JSONPatchOperationTypeRemove,
+		JSONPatchOperationTypeReplace, JSONPatchOperationTypeTest:
+	case JSONPatchOperationTypeMove, JSONPatchOperationTypeCopy:
+		return fmt.Errorf("op %q is not supported, as it needs a \"from\" path", o.Operation)
+	default:
+		return fmt.Errorf("op %q is not a valid JSON patch operation", o.Operation)
+	}
+
+	if !strings.HasPrefix(o.Path, "/") {
+		return fmt.Errorf("path %q must start with a /", o.Path)
+	}
+
+	t := reflect.TypeOf(corev1.PodTemplateSpec{})
+	for _, segment := range strings.Split(o.Path[1:], "/") {
+		// https://www.rfc-editor.org/rfc/rfc6901#section-4
+		segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			field, ok := jsonField(t, segment)
+			if !ok {
+				return fmt.Errorf("path %q is invalid: %q is not a field of %s", o.Path, segment, t.Name())
+			}
+			t = field.Type
+		case reflect.Slice, reflect.Array:
+			if _, err := strconv.Atoi(segment); err != nil && segment != "-" {
+				return fmt.Errorf("path %q is invalid: %q is not a list index", o.Path, segment)
+			}
+			t = t.Elem()
+		case reflect.Map:
+			t = t.Elem()
+		default:
+			return fmt.Errorf("path %q is invalid: %q is inside a %s value", o.Path, segment, t.Kind())
+		}
+	}
+	return nil
+}
+
+// jsonField returns the field of the struct type that is serialized with the
+// supplied JSON name, looking into inlined structs too.
+func jsonField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tagName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		if field.Anonymous && tagName == "" && field.Type.Kind() == reflect.Struct {
+			if inlined, ok := jsonField(field.Type, name); ok {
+				return inlined, true
+			}
+			continue
+		}
+		if !field.IsExported() || tagName == "-" {
+			continue
+		}
+		if tagName == "" {
+			tagName = field.Name
+		}
+		if tagName == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// Validate verifies the parts of the configuration that can be checked without
+// the PodTemplateSpec it is applied to - currently the PatchSpecOperations.
+func (c *PodTemplateSpecMutationConfig) Validate() error {
+	for i, op := range c.PatchSpecOperations {
+		if err := op.Validate(); err != nil {
+			return fmt.Errorf(
+				"invalid spec.controllerTargetMutationConfig.patchSpecOperations[%d]: %w", i, err,
+			)
+		}
+	}
+	return nil
+}
+
 // getDefaultContainerID returns the numerical identifier of the container within the
 // PodSpec.Containers[] list that the mutation configuration should apply to.
 //
@@ -344,3 +432,63 @@ func (c *PodTemplateSpecMutationConfig) PatchPodTemplateSpec(
 
 	return n, nil
 }
+
+// ApplyResources returns a new PodTemplateSpec with the supplied resource
+// requests and limits set on the "default" container (see
+// getDefaultContainerID). Only the resources named in res are changed. If a
+// request is raised above the existing limit for that resource (or a limit is
+// lowered below the existing request), and res does not set the other value
+// too, it is moved to match so that the Pod remains valid.
+//
+// Returns:
+//
+//	corev1.PodTemplateSpec: A new PodTemplateSpec with the resources applied.
+//	corev1.ResourceRequirements: The resulting resources of the default container.
+func (c *PodTemplateSpecMutationConfig) ApplyResources(
+	ctx context.Context,
+	orig corev1.PodTemplateSpec,
+	res corev1.ResourceRequirements,
+) (corev1.PodTemplateSpec, corev1.ResourceRequirements, error) {
+	logger := log.FromContext(ctx)
+	n := *orig.DeepCopy()
+
+	defContainerID, err := c.getDefaultContainerID(ctx, orig)
+	if err != nil {
+		return orig, corev1.ResourceRequirements{}, err
+	}
+	cont := &n.Spec.Containers[defContainerID]
+
+	for name, qty := range res.Requests {
+		logger.V(1).Info(fmt.Sprintf(
+			"Setting spec.containers[%d].resources.requests.%s: %s", defContainerID, name, qty.String(),
+		))
+		if cont.Resources.Requests == nil {
+			cont.Resources.Requests = corev1.ResourceList{}
+		}
+		cont.Resources.Requests[name] = qty
+
+		if limit, ok := cont.Resources.Limits[name]; ok && limit.Cmp(qty) < 0 {
+			if _, set := res.Limits[name]; !set {
+				cont.Resources.Limits[name] = qty
+			}
+		}
+	}
+
+	for name, qty := range res.Limits {
+		logger.V(1).Info(fmt.Sprintf(
+			"Setting spec.containers[%d].resources.limits.%s: %s", defContainerID, name, qty.String(),
+		))
+		if cont.Resources.Limits == nil {
+			cont.Resources.Limits = corev1.ResourceList{}
+		}
+		cont.Resources.Limits[name] = qty
+
+		if request, ok := cont.Resources.Requests[name]; ok && request.Cmp(qty) > 0 {
+			if _, set := res.Requests[name]; !set {
+				cont.Resources.Requests[name] = qty
+			}
+		}
+	}
+
+	return n, *cont.Resources.DeepCopy(), nil
+}
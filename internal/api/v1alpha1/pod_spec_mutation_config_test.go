@@ -352,5 +352,76 @@ var _ = Describe("PodSpecMutationConfig", Ordered, func() {
 				},
 			))
 		})
+
+		It("ApplyResources should set resources on the default container", func() {
+			config := &PodTemplateSpecMutationConfig{DefaultContainerName: "contB"}
+			podTemplateSpec.Spec.Containers[1].Resources = v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("100m"),
+					v1.ResourceMemory: resource.MustParse("64Mi"),
+				},
+				Limits: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("1"),
+					v1.ResourceMemory: resource.MustParse("128Mi"),
+				},
+			}
+
+			// Run it
+			ret, granted, err := config.ApplyResources(ctx, podTemplateSpec, v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("4"),
+					v1.ResourceMemory: resource.MustParse("32Mi"),
+				},
+				Limits: v1.ResourceList{
+					v1.ResourceMemory: resource.MustParse("256Mi"),
+				},
+			})
+			Expect(err).To(Not(HaveOccurred()))
+
+			// VERIFY: Only the default container was changed
+			Expect(ret.Spec.Containers[0].Resources).To(Equal(v1.ResourceRequirements{}))
+			res := ret.Spec.Containers[1].Resources
+			Expect(granted).To(Equal(res))
+
+			// VERIFY: Requests were applied
+			Expect(res.Requests.Cpu().String()).To(Equal("4"))
+			Expect(res.Requests.Memory().String()).To(Equal("32Mi"))
+
+			// VERIFY: The CPU limit was raised to match the new request
+			Expect(res.Limits.Cpu().String()).To(Equal("4"))
+			Expect(res.Limits.Memory().String()).To(Equal("256Mi"))
+
+			// VERIFY: The original was not modified
+			Expect(podTemplateSpec.Spec.Containers[1].Resources.Requests.Cpu().String()).
+				To(Equal("100m"))
+		})
+
+		It("ApplyResources should lower requests to a new lower limit", func() {
+			config := &PodTemplateSpecMutationConfig{}
+			podTemplateSpec.Spec.Containers[0].Resources = v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			}
+
+			// Run it
+			ret, _, err := config.ApplyResources(ctx, podTemplateSpec, v1.ResourceRequirements{
+				Limits: v1.ResourceList{
+					v1.ResourceMemory: resource.MustParse("512Mi"),
+				},
+			})
+			Expect(err).To(Not(HaveOccurred()))
+
+			// VERIFY: The request was lowered to match the limit
+			res := ret.Spec.Containers[0].Resources
+			Expect(res.Requests.Memory().String()).To(Equal("512Mi"))
+			Expect(res.Limits.Memory().String()).To(Equal("512Mi"))
+		})
+
+		It("ApplyResources should fail if invalid container name supplied", func() {
+			config := &PodTemplateSpecMutationConfig{DefaultContainerName: "invalid"}
+			_, _, err := config.ApplyResources(ctx, podTemplateSpec, v1.ResourceRequirements{})
+			Expect(err).To(HaveOccurred())
+		})
 	})
 })
@@ -0,0 +1,141 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/diranged/oz/internal/testing/utils"
+)
+
+var _ = Describe("PortForwardAccessRequest", Ordered, func() {
+	var (
+		namespace *corev1.Namespace
+		template  *PortForwardAccessTemplate
+		request   *PortForwardAccessRequest
+
+		admissionRequest = admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				UserInfo: authenticationv1.UserInfo{
+					Username: "admin",
+					Groups:   []string{"admins"},
+				},
+			},
+		}
+	)
+
+	// This Context() tests specific functions - no real calls against the API
+	// are made here, other than the lookup of the PortForwardAccessTemplate.
+	Context("Functional Unit Tests", func() {
+		BeforeEach(func() {
+			request = &PortForwardAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: namespace.Name,
+				},
+				Spec: PortForwardAccessRequestSpec{
+					TemplateName: template.Name,
+				},
+			}
+		})
+
+		It("ValidateCreate() should allow a request without ports...", func() {
+			_, err := request.ValidateCreate(webhookCtx, admissionRequest)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(request.GetPorts(template)).To(Equal([]int32{5432, 8080}))
+		})
+
+		It("ValidateCreate() should allow a request for an allowed port...", func() {
+			request.Spec.Ports = []int32{8080}
+			_, err := request.ValidateCreate(webhookCtx, admissionRequest)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(request.GetPorts(template)).To(Equal([]int32{8080}))
+		})
+
+		It("ValidateCreate() should reject a request for a port that is not allowed...", func() {
+			request.Spec.Ports = []int32{8080, 22}
+			_, err := request.ValidateCreate(webhookCtx, admissionRequest)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("port 22 is not one of the allowedPorts"))
+		})
+
+		It("ValidateCreate() should reject a request for a missing template...", func() {
+			request.Spec.TemplateName = "missing"
+			_, err := request.ValidateCreate(webhookCtx, admissionRequest)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("ValidateUpdate() should reject changes to Spec.Ports...", func() {
+			oldReq := request.DeepCopy()
+			oldReq.Spec.Ports = []int32{5432}
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.Ports = []int32{8080}
+			_, err := newReq.ValidateUpdate(webhookCtx, admission.Request{}, oldReq)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("ValidateUpdate() should reject changes to Spec.TargetPod...", func() {
+			oldReq := request.DeepCopy()
+			newReq := oldReq.DeepCopy()
+			newReq.Spec.TargetPod = "other"
+			_, err := newReq.ValidateUpdate(webhookCtx, admission.Request{}, oldReq)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("ValidateUpdate() should allow metadata changes...", func() {
+			oldReq := request.DeepCopy()
+			newReq := oldReq.DeepCopy()
+			newReq.SetAnnotations(map[string]string{"foo": "bar"})
+			_, err := newReq.ValidateUpdate(webhookCtx, admission.Request{}, oldReq)
+			Expect(err).To(Not(HaveOccurred()))
+		})
+	})
+
+	// Setup code below here - this code rarely changes, the tests above are
+	// much more important.
+	BeforeAll(func() {
+		By("Creating the Namespace to perform the tests")
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: testutil.RandomString(8),
+			},
+		}
+		err := k8sClient.Create(ctx, namespace)
+		Expect(err).To(Not(HaveOccurred()))
+
+		By("Creating the PortForwardAccessTemplate to perform the tests")
+		template = &PortForwardAccessTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: namespace.Name,
+			},
+			Spec: PortForwardAccessTemplateSpec{
+				AccessConfig: AccessConfig{
+					AllowedGroups:   []string{"admins"},
+					DefaultDuration: "1h",
+					MaxDuration:     "24h",
+				},
+				ControllerTargetRef: &CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "test-deployment",
+				},
+				AllowedPorts: []int32{5432, 8080},
+			},
+		}
+		err = k8sClient.Create(ctx, template)
+		Expect(err).To(Not(HaveOccurred()))
+	})
+
+	AfterAll(func() {
+		By("Deleting the Namespace for tests")
+		err := k8sClient.Delete(ctx, namespace)
+		Expect(err).To(Not(HaveOccurred()))
+	})
+})
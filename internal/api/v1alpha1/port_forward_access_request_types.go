@@ -0,0 +1,237 @@
+/*
+Copyright 2022 Matt Wise.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PortForwardAccessRequestSpec defines the desired state of PortForwardAccessRequest
+type PortForwardAccessRequestSpec struct {
+	// Defines the name of the `PortForwardAccessTemplate` that should be used
+	// to grant access to the target resource.
+	//
+	// +kubebuilder:validation:Required
+	TemplateName string `json:"templateName"`
+
+	// TargetPod is used to explicitly define the target pod that the
+	// port-forward privileges should be granted to. If not supplied, then a
+	// random pod is chosen.
+	TargetPod string `json:"targetPod,omitempty"`
+
+	// Ports lists the ports on the target Pod that should be forwarded. Each
+	// port must be one of the allowedPorts in the PortForwardAccessTemplate.
+	// If omitted, all of the allowedPorts are used.
+	//
+	// +kubebuilder:validation:Optional
+	Ports []int32 `json:"ports,omitempty"`
+
+	// Duration sets the length of time from the `spec.startTime` (or the `metadata.creationTimestamp`
+	// if no start time is set) that this object will live. After the time has expired, the resouce
+	// will be automatically deleted on the next reconcilliation loop.
+	//
+	// If omitted, the spec.defautlDuration from the PortForwardAccessTemplate is used.
+	//
+	// Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	Duration string `json:"duration,omitempty"`
+
+	// StartTime schedules the access to begin at a later time. Until then,
+	// the request is kept in a Scheduled state and no access resources are
+	// created. The Spec.duration is measured from this time, and it cannot
+	// be changed after creation. It cannot be in the past - access cannot
+	// be backdated.
+	//
+	// If omitted, the access begins as soon as the request is created.
+	//
+	// +kubebuilder:validation:Optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// RequestedBy records the identity of the user that created this
+	// request. This field is set by the Oz admission webhook - any value
+	// supplied by the user is overwritten, and it cannot be changed after
+	// creation.
+	//
+	// +kubebuilder:validation:Optional
+	RequestedBy *RequesterInfo `json:"requestedBy,omitempty"`
+
+	// Revoked ends the access granted by this request early. Once set it
+	// cannot be unset, and the request is deleted on the next reconciliation
+	// loop.
+	//
+	// +kubebuilder:validation:Optional
+	Revoked bool `json:"revoked,omitempty"`
+
+	// RevokedBy records the identity of the user that revoked this request.
+	// This field is set by the Oz admission webhook - any value supplied by
+	// the user is overwritten.
+	//
+	// +kubebuilder:validation:Optional
+	RevokedBy *RequesterInfo `json:"revokedBy,omitempty"`
+}
+
+// PortForwardAccessRequestStatus defines the observed state of PortForwardAccessRequest
+type PortForwardAccessRequestStatus struct {
+	CoreStatus `json:",inline"`
+
+	// The Target Pod Name where access has been granted
+	PodName string `json:"podName,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// PortForwardAccessRequest is the Schema for the portforwardaccessrequests API
+//
+// +kubebuilder:printcolumn:name="Template",type="string",JSONPath=".spec.templateName",description="Access Template"
+// +kubebuilder:printcolumn:name="User",type="string",JSONPath=".spec.requestedBy.username",description="Requesting User"
+// +kubebuilder:printcolumn:name="Pod",type="string",JSONPath=".status.podName",description="Target Pod Name"
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Is request ready?"
+// +kubebuilder:printcolumn:name="Starts",type="string",format="date-time",JSONPath=".spec.startTime",description="When the access starts",priority=1
+// +kubebuilder:printcolumn:name="Expires",type="string",format="date-time",JSONPath=".status.expiresAt",description="When the access expires"
+type PortForwardAccessRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PortForwardAccessRequestSpec   `json:"spec,omitempty"`
+	Status PortForwardAccessRequestStatus `json:"status,omitempty"`
+}
+
+// https://stackoverflow.com/questions/33089523/how-to-mark-golang-struct-as-implementing-interface
+var (
+	_ IPodRequestResource = &PortForwardAccessRequest{}
+	_ IPodRequestResource = (*PortForwardAccessRequest)(nil)
+)
+
+// GetStatus implements the ICoreResource interface
+func (r *PortForwardAccessRequest) GetStatus() ICoreStatus {
+	return &r.Status
+}
+
+// GetTemplate returns a populated PortForwardAccessTemplate that this PortForwardAccessRequest is referencing.
+func (r *PortForwardAccessRequest) GetTemplate(
+	ctx context.Context,
+	cl client.Client,
+) (ITemplateResource, error) {
+	return GetPortForwardAccessTemplate(ctx, cl, r.Spec.TemplateName, r.Namespace)
+}
+
+// GetTemplateName returns the user supplied Spec.templateName field
+func (r *PortForwardAccessRequest) GetTemplateName() string {
+	return r.Spec.TemplateName
+}
+
+// GetDuration conforms to the interfaces.OzRequestResource interface
+func (r *PortForwardAccessRequest) GetDuration() (time.Duration, error) {
+	if r.Spec.Duration != "" {
+		return time.ParseDuration(r.Spec.Duration)
+	}
+	return time.Duration(0), nil
+}
+
+// GetStartTime conforms to the interfaces.OzRequestResource interface. A
+// Spec.startTime before the creation of the request is ignored, so that the
+// access can never be backdated.
+func (r *PortForwardAccessRequest) GetStartTime() time.Time {
+	if r.Spec.StartTime != nil && r.Spec.StartTime.After(r.CreationTimestamp.Time) {
+		return r.Spec.StartTime.Time
+	}
+	return r.CreationTimestamp.Time
+}
+
+// GetUptime conforms to the interfaces.OzRequestResource interface
+func (r *PortForwardAccessRequest) GetUptime() time.Duration {
+	return time.Since(r.GetStartTime())
+}
+
+// GetRequestedBy conforms to the interfaces.OzRequestResource interface
+func (r *PortForwardAccessRequest) GetRequestedBy() *RequesterInfo {
+	return r.Spec.RequestedBy
+}
+
+// IsRevoked conforms to the interfaces.OzRequestResource interface
+func (r *PortForwardAccessRequest) IsRevoked() bool {
+	return r.Spec.Revoked
+}
+
+// GetRevokedBy conforms to the interfaces.OzRequestResource interface
+func (r *PortForwardAccessRequest) GetRevokedBy() *RequesterInfo {
+	return r.Spec.RevokedBy
+}
+
+// SetRevokedBy conforms to the interfaces.OzRequestResource interface
+func (r *PortForwardAccessRequest) SetRevokedBy(info *RequesterInfo) {
+	r.Spec.RevokedBy = info
+}
+
+// SetPodName conforms to the interfaces.OzRequestResource interface
+func (r *PortForwardAccessRequest) SetPodName(name string) error {
+	if (r.Status.PodName != "") && (r.Status.PodName != name) {
+		return fmt.Errorf(
+			"immutable field Status.PodName already set (%s), cannot update to %s",
+			r.Status.PodName,
+			name,
+		)
+	}
+	r.Status.PodName = name
+	return nil
+}
+
+// GetPodName conforms to the interfaces.OzRequestResource interface
+func (r *PortForwardAccessRequest) GetPodName() string {
+	return r.Status.PodName
+}
+
+// GetPorts returns the Spec.ports to forward, or the allowedPorts of the
+// supplied template if none were requested.
+func (r *PortForwardAccessRequest) GetPorts(tmpl *PortForwardAccessTemplate) []int32 {
+	if len(r.Spec.Ports) == 0 {
+		return tmpl.Spec.AllowedPorts
+	}
+	return r.Spec.Ports
+}
+
+// VerifyPorts returns an error if any of the Spec.ports are not allowed by
+// the supplied template.
+func (r *PortForwardAccessRequest) VerifyPorts(tmpl *PortForwardAccessTemplate) error {
+	for _, port := range r.Spec.Ports {
+		if !tmpl.IsPortAllowed(port) {
+			return fmt.Errorf(
+				"port %d is not one of the allowedPorts %v of PortForwardAccessTemplate %q",
+				port, tmpl.Spec.AllowedPorts, tmpl.GetName(),
+			)
+		}
+	}
+	return nil
+}
+
+//+kubebuilder:object:root=true
+
+// PortForwardAccessRequestList contains a list of PortForwardAccessRequest
+type PortForwardAccessRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PortForwardAccessRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PortForwardAccessRequest{}, &PortForwardAccessRequestList{})
+}
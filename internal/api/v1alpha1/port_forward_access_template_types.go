@@ -0,0 +1,131 @@
+/*
+Copyright 2022 Matt Wise.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PortForwardAccessTemplateSpec defines the desired state of PortForwardAccessTemplate
+type PortForwardAccessTemplateSpec struct {
+	// AccessConfig provides a common struct for defining who has access to the resources this
+	// template controls, how long they have access, etc.
+	//
+	// The AccessConfig.accessCommand setting is not used by this template -
+	// the access message is always a "kubectl port-forward ..." command for
+	// the requested ports.
+	AccessConfig AccessConfig `json:"accessConfig"`
+
+	// ControllerTargetRef provides a pattern for referencing objects from another API in a generic way.
+	//
+	// +kubebuilder:validation:Required
+	ControllerTargetRef *CrossVersionObjectReference `json:"controllerTargetRef"`
+
+	// AllowedPorts lists the ports on the target Pod that a
+	// PortForwardAccessRequest may ask to forward.
+	//
+	// Note: Kubernetes RBAC cannot restrict "pods/portforward" to specific
+	// ports. The AllowedPorts are enforced when the request is created, and
+	// are used to render the access command - but a user that has been
+	// granted access can forward any port on the target Pod.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	AllowedPorts []int32 `json:"allowedPorts"`
+}
+
+// PortForwardAccessTemplateStatus defines the observed state of PortForwardAccessTemplate
+type PortForwardAccessTemplateStatus struct {
+	CoreStatus    `json:",inline"`
+	TemplateUsage `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// PortForwardAccessTemplate is the Schema for the portforwardaccesstemplates API
+//
+// +kubebuilder:printcolumn:name="Ports",type="string",JSONPath=".spec.allowedPorts",description="Allowed Ports"
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Is template ready?"
+// +kubebuilder:printcolumn:name="Active",type="integer",JSONPath=".status.activeRequestCount",description="Number of active Access Requests"
+// +kubebuilder:printcolumn:name="Used",type="integer",JSONPath=".status.usageCount",description="Number of Access Requests made over the lifetime of the template"
+// +kubebuilder:printcolumn:name="Last Used",type="date",JSONPath=".status.lastUsedTime",description="Time of the most recent Access Request"
+type PortForwardAccessTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PortForwardAccessTemplateSpec   `json:"spec,omitempty"`
+	Status PortForwardAccessTemplateStatus `json:"status,omitempty"`
+}
+
+// https://stackoverflow.com/questions/33089523/how-to-mark-golang-struct-as-implementing-interface
+var (
+	_ ITemplateResource = &PortForwardAccessTemplate{}
+	_ ITemplateResource = (*PortForwardAccessTemplate)(nil)
+)
+
+// GetStatus returns the core Status field for this resource.
+func (t *PortForwardAccessTemplate) GetStatus() ICoreStatus {
+	return &t.Status
+}
+
+// GetAccessConfig returns the Spec.accessConfig field for this resource in an AccessConfig object form.
+func (t *PortForwardAccessTemplate) GetAccessConfig() *AccessConfig {
+	return &t.Spec.AccessConfig
+}
+
+// GetTargetRef conforms to the controllers.OzTemplateResource interface.
+func (t *PortForwardAccessTemplate) GetTargetRef() *CrossVersionObjectReference {
+	return t.Spec.ControllerTargetRef
+}
+
+// IsPortAllowed returns true if the supplied port is one of the Spec.allowedPorts.
+func (t *PortForwardAccessTemplate) IsPortAllowed(port int32) bool {
+	return slices.Contains(t.Spec.AllowedPorts, port)
+}
+
+// GetPortForwardAccessTemplate returns back a PortForwardAccessTemplate
+// resource matching the supplied name and namespace, or returns back an
+// error.
+func GetPortForwardAccessTemplate(
+	ctx context.Context,
+	cl client.Reader,
+	name string,
+	namespace string,
+) (*PortForwardAccessTemplate, error) {
+	tmpl := &PortForwardAccessTemplate{}
+	err := cl.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, tmpl)
+	return tmpl, err
+}
+
+//+kubebuilder:object:root=true
+
+// PortForwardAccessTemplateList contains a list of PortForwardAccessTemplate
+type PortForwardAccessTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PortForwardAccessTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PortForwardAccessTemplate{}, &PortForwardAccessTemplateList{})
+}
@@ -0,0 +1,285 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/diranged/oz/internal/webhook"
+)
+
+// getWebhookClient returns the client that the admission webhooks use to
+// look up the resources (eg, Access Templates) that an object references. The
+// webhook handlers supply it through the context of each admission request.
+func getWebhookClient(ctx context.Context) (client.Client, error) {
+	cl := webhook.ClientFromContext(ctx)
+	if cl == nil {
+		return nil, fmt.Errorf("webhook client has not been initialized")
+	}
+	return cl, nil
+}
+
+// getRequestTemplate looks up the Access Template referenced by the request,
+// so that the admission checks of a new request can share a single lookup.
+func getRequestTemplate(ctx context.Context, req IRequestResource) (ITemplateResource, error) {
+	cl, err := getWebhookClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := req.GetTemplate(ctx, cl)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to get Access Template %q: %w",
+			req.GetTemplateName(), err,
+		)
+	}
+	return tmpl, nil
+}
+
+// verifyRequesterAllowed verifies that the user creating a request is a
+// member of at least one of the AllowedGroups of its Access Template.
+func verifyRequesterAllowed(
+	tmpl ITemplateResource,
+	userInfo authenticationv1.UserInfo,
+) error {
+	allowedGroups := tmpl.GetAccessConfig().GetAllowedGroups()
+	if !tmpl.GetAccessConfig().IsAllowed(userInfo.Groups) {
+		return fmt.Errorf(
+			"user %q is not a member of any of the allowedGroups (%s) of Access Template %q",
+			userInfo.Username, strings.Join(allowedGroups, ", "), tmpl.GetName(),
+		)
+	}
+	return nil
+}
+
+// verifyConcurrencyLimits verifies that a new request does not take the number
+// of live Access Requests against its Access Template over the concurrency
+// limits of the template.
+//
+// Requests that are scheduled to start later are let through - the live
+// requests at that time are not known yet, so the controller checks the
+// limits once the access starts.
+func verifyConcurrencyLimits(
+	ctx context.Context,
+	req IRequestResource,
+	tmpl ITemplateResource,
+	userInfo authenticationv1.UserInfo,
+) error {
+	if req.GetStartTime().After(time.Now()) {
+		return nil
+	}
+
+	accessConfig := tmpl.GetAccessConfig()
+	if !accessConfig.HasConcurrencyLimits() {
+		return nil
+	}
+
+	cl, err := getWebhookClient(ctx)
+	if err != nil {
+		return err
+	}
+	live, err := ListLiveRequests(ctx, cl, req, req.GetNamespace(), tmpl.GetName(), time.Now())
+	if err != nil {
+		return err
+	}
+	return accessConfig.VerifyConcurrencyLimits(tmpl.GetName(), userInfo.Username, live)
+}
+
+// startTimeSkew is how far in the past the Spec.startTime of a new request
+// may be, to allow for clock skew between the client and the API server.
+const startTimeSkew = time.Minute
+
+// verifyStartTime verifies that the Spec.startTime of a new request is not
+// in the past. Access can be scheduled for later, but it can never be
+// backdated - that would shorten (or entirely use up) the Spec.duration
+// before the access is even granted.
+func verifyStartTime(startTime *metav1.Time, now time.Time) error {
+	if startTime == nil || !startTime.Time.Before(now.Add(-startTimeSkew)) {
+		return nil
+	}
+	return fmt.Errorf(
+		"spec.startTime (%s) is in the past, omit it to start the access right away",
+		startTime.UTC().Format(time.RFC3339),
+	)
+}
+
+// verifyAllowedWindows verifies that the access of a new request starts while
+// one of the allowedWindows of its Access Template is open. Access starts
+// right away, unless the request is scheduled for later with a
+// Spec.startTime.
+func verifyAllowedWindows(req IRequestResource, tmpl ITemplateResource) error {
+	accessConfig := tmpl.GetAccessConfig()
+	if !accessConfig.HasAllowedWindows() {
+		return nil
+	}
+
+	// The request has not been created yet, so it has no creationTimestamp.
+	start := time.Now()
+	if startTime := req.GetStartTime(); startTime.After(start) {
+		start = startTime
+	}
+	_, open, err := accessConfig.GetWindowClose(start)
+	if err != nil {
+		return err
+	}
+	if !open {
+		return fmt.Errorf(
+			"access to Access Template %q can only start during its allowedWindows (%s), and %s is outside of them",
+			tmpl.GetName(), accessConfig.DescribeAllowedWindows(), start.UTC().Format(time.RFC3339),
+		)
+	}
+	return nil
+}
+
+// verifyDurationUpdate is called when an Access Request is updated, and
+// verifies any change to its Spec.duration. Only the user that created the
+// request may change it - requests without a Spec.requestedBy can never be
+// extended. The duration is always measured from the start of
+// the access (see GetStartTime()), so it can be extended up to the MaxDuration
+// of the template. Requests that have already expired cannot be extended.
+func verifyDurationUpdate(
+	ctx context.Context,
+	req IRequestResource,
+	old IRequestResource,
+	userInfo authenticationv1.UserInfo,
+) error {
+	newDuration, err := req.GetDuration()
+	if err != nil {
+		return fmt.Errorf("invalid spec.duration: %w", err)
+	}
+	oldDuration, _ := old.GetDuration()
+	if newDuration == oldDuration {
+		return nil
+	}
+
+	// Without a known requester, nobody can be allowed to extend the access.
+	requester := old.GetRequestedBy()
+	if requester == nil || requester.Username == "" {
+		return fmt.Errorf(
+			"%s has no known requester, so its spec.duration cannot be changed",
+			old.GetName(),
+		)
+	}
+	if requester.Username != userInfo.Username {
+		return fmt.Errorf(
+			"only the requester (%s) can change the spec.duration of %s",
+			requester.Username, old.GetName(),
+		)
+	}
+
+	cl, err := getWebhookClient(ctx)
+	if err != nil {
+		return err
+	}
+	tmpl, err := req.GetTemplate(ctx, cl)
+	if err != nil {
+		return fmt.Errorf(
+			"unable to get Access Template %q: %w",
+			req.GetTemplateName(), err,
+		)
+	}
+
+	maxDuration, err := tmpl.GetAccessConfig().GetMaxDuration()
+	if err != nil {
+		return err
+	}
+	if newDuration > maxDuration {
+		return fmt.Errorf(
+			"spec.duration (%s) is above the maxDuration (%s) of Access Template %q, measured from the start of the access",
+			newDuration, maxDuration, tmpl.GetName(),
+		)
+	}
+
+	// The access that was in effect before the change - if that has already
+	// run out, the request is about to be deleted.
+	if oldDuration == 0 {
+		if oldDuration, err = tmpl.GetAccessConfig().GetDefaultDuration(); err != nil {
+			return err
+		}
+	}
+	if old.GetUptime() > oldDuration {
+		return fmt.Errorf(
+			"%s has already expired, create a new request instead",
+			old.GetName(),
+		)
+	}
+	return nil
+}
+
+// defaultRevokedBy is called by the mutating webhooks, and records the user
+// that revoked the request in its Spec.revokedBy field. The field is only set
+// when the request is first revoked, on every other operation it is left
+// alone so that the validating webhook can reject any change to it.
+//
+// The old argument is decoded from the admission request, and should be an
+// empty object of the same type as req.
+func defaultRevokedBy(req admission.Request, r IRequestResource, old IRequestResource) error {
+	switch req.Operation {
+	case admissionv1.Create:
+		// A request may be created revoked (however pointless) - but the
+		// revoker must still be recorded accurately.
+		r.SetRevokedBy(nil)
+		if r.IsRevoked() {
+			r.SetRevokedBy(NewRequesterInfo(req.UserInfo))
+		}
+	case admissionv1.Update:
+		if len(req.OldObject.Raw) == 0 {
+			return nil
+		}
+		if err := json.Unmarshal(req.OldObject.Raw, old); err != nil {
+			return fmt.Errorf("unable to decode the existing %s: %w", req.Kind.Kind, err)
+		}
+		if r.IsRevoked() && !old.IsRevoked() {
+			r.SetRevokedBy(NewRequesterInfo(req.UserInfo))
+		}
+	}
+	return nil
+}
+
+// verifyRevocationUpdate is called when an Access Request is updated, and
+// verifies any change to its Spec.revoked and Spec.revokedBy fields. A
+// revocation cannot be undone, and Spec.revokedBy must always identify the
+// user that revoked the request.
+func verifyRevocationUpdate(
+	req IRequestResource,
+	old IRequestResource,
+	userInfo authenticationv1.UserInfo,
+) error {
+	if old.IsRevoked() {
+		if !req.IsRevoked() {
+			return fmt.Errorf(
+				"%s has been revoked, create a new request instead",
+				old.GetName(),
+			)
+		}
+		if !equality.Semantic.DeepEqual(req.GetRevokedBy(), old.GetRevokedBy()) {
+			return fmt.Errorf("error - Spec.RevokedBy is an immutable field")
+		}
+		return nil
+	}
+
+	if !req.IsRevoked() {
+		if req.GetRevokedBy() != nil {
+			return fmt.Errorf("error - Spec.RevokedBy can only be set by revoking the request")
+		}
+		return nil
+	}
+
+	if revoker := req.GetRevokedBy(); revoker == nil || revoker.Username != userInfo.Username {
+		return fmt.Errorf(
+			"error - Spec.RevokedBy must identify the revoking user (%s)",
+			userInfo.Username,
+		)
+	}
+	return nil
+}
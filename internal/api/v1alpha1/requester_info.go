@@ -0,0 +1,34 @@
+package v1alpha1
+
+import (
+	authenticationv1 "k8s.io/api/authentication/v1"
+)
+
+// RequesterInfo records the identity of the user that created an Access
+// Request. It is populated by the Oz mutating webhook from the admission
+// request, and cannot be supplied or changed by the user.
+type RequesterInfo struct {
+	// Username is the name of the user that created the Access Request.
+	Username string `json:"username"`
+
+	// UID is the unique identifier of the user that created the Access
+	// Request, if one was supplied by the authenticator.
+	UID string `json:"uid,omitempty"`
+
+	// Groups are the groups that the user belonged to at the time the Access
+	// Request was created.
+	Groups []string `json:"groups,omitempty"`
+}
+
+// NewRequesterInfo builds a RequesterInfo from the UserInfo of an admission
+// request. Returns nil if the UserInfo does not contain a username.
+func NewRequesterInfo(userInfo authenticationv1.UserInfo) *RequesterInfo {
+	if userInfo.Username == "" {
+		return nil
+	}
+	return &RequesterInfo{
+		Username: userInfo.Username,
+		UID:      userInfo.UID,
+		Groups:   userInfo.Groups,
+	}
+}
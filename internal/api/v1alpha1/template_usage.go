@@ -0,0 +1,68 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// TemplateUsage provides the common Status fields that record how an Access
+// Template is being used. It is maintained by the TemplateReconciler, which
+// watches the Access Requests that reference the template.
+type TemplateUsage struct {
+	// ActiveRequestCount is the number of Access Requests that currently
+	// reference this template, have started, and have not expired or been
+	// revoked. Requests scheduled to start later are not counted until then.
+	//
+	// +kubebuilder:validation:Optional
+	ActiveRequestCount int `json:"activeRequestCount"`
+
+	// ActiveRequests lists the Access Requests counted in ActiveRequestCount.
+	//
+	// +kubebuilder:validation:Optional
+	ActiveRequests []ActiveRequest `json:"activeRequests,omitempty"`
+
+	// UsageCount is the total number of Access Requests that have been made
+	// against this template over its lifetime. Each request is counted by
+	// the controller when it first sees the request, so requests that expire
+	// or are deleted right away are counted too.
+	//
+	// +kubebuilder:validation:Optional
+	UsageCount int64 `json:"usageCount"`
+
+	// LastUsedTime is the creation time of the most recent Access Request
+	// made against this template.
+	//
+	// +kubebuilder:validation:Optional
+	LastUsedTime *metav1.Time `json:"lastUsedTime,omitempty"`
+}
+
+// ActiveRequest describes a live Access Request in the TemplateUsage.
+type ActiveRequest struct {
+	// Name of the Access Request
+	Name string `json:"name"`
+
+	// UID of the Access Request
+	UID types.UID `json:"uid,omitempty"`
+
+	// RequestedBy is the username of the user that created the request, if
+	// it is known.
+	RequestedBy string `json:"requestedBy,omitempty"`
+
+	// ExpiresAt is the time at which the access expires, once it has been
+	// worked out by the controller.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// https://stackoverflow.com/questions/33089523/how-to-mark-golang-struct-as-implementing-interface
+var (
+	_ ITemplateStatus = &EphemeralContainerAccessTemplateStatus{}
+	_ ITemplateStatus = &ExecAccessTemplateStatus{}
+	_ ITemplateStatus = &LogAccessTemplateStatus{}
+	_ ITemplateStatus = &PodAccessTemplateStatus{}
+	_ ITemplateStatus = &PortForwardAccessTemplateStatus{}
+)
+
+// GetUsage returns a pointer to the TemplateUsage fields of the Status.
+func (in *TemplateUsage) GetUsage() *TemplateUsage {
+	return in
+}
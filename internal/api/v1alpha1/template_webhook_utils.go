@@ -0,0 +1,40 @@
+package v1alpha1
+
+import "fmt"
+
+// hasTargetRef returns true if the template has a non-empty
+// Spec.controllerTargetRef.
+func hasTargetRef(tmpl ITemplateResource) bool {
+	ref := tmpl.GetTargetRef()
+	return ref != nil && *ref != (CrossVersionObjectReference{})
+}
+
+// validateTemplate verifies the settings that every kind of Access Template
+// shares - its durations, its concurrency limits, its allowed windows, its
+// Spec.controllerTargetRef (if it has one) and its supplied Spec.accessRules.
+// Anything that is caught here would otherwise only surface once the
+// TemplateReconciler (or an Access Request) trips over it.
+func validateTemplate(tmpl ITemplateResource, rules []AccessRule) error {
+	if err := tmpl.GetAccessConfig().ValidateDurations(); err != nil {
+		return err
+	}
+	if err := tmpl.GetAccessConfig().ValidateConcurrencyLimits(); err != nil {
+		return err
+	}
+	if err := tmpl.GetAccessConfig().ValidateAllowedWindows(); err != nil {
+		return err
+	}
+
+	if hasTargetRef(tmpl) {
+		if err := tmpl.GetTargetRef().Validate(); err != nil {
+			return err
+		}
+	}
+
+	for i, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("invalid spec.accessRules[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
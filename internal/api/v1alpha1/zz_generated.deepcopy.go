@@ -25,6 +25,100 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessApproval) DeepCopyInto(out *AccessApproval) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessApproval.
+func (in *AccessApproval) DeepCopy() *AccessApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessApproval) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessApprovalList) DeepCopyInto(out *AccessApprovalList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessApprovalList.
+func (in *AccessApprovalList) DeepCopy() *AccessApprovalList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessApprovalList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessApprovalList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessApprovalSpec) DeepCopyInto(out *AccessApprovalSpec) {
+	*out = *in
+	if in.ApproverGroups != nil {
+		in, out := &in.ApproverGroups, &out.ApproverGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessApprovalSpec.
+func (in *AccessApprovalSpec) DeepCopy() *AccessApprovalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessApprovalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessApprovalStatus) DeepCopyInto(out *AccessApprovalStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessApprovalStatus.
+func (in *AccessApprovalStatus) DeepCopy() *AccessApprovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessApprovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessConfig) DeepCopyInto(out *AccessConfig) {
 	*out = *in
@@ -33,6 +127,18 @@ func (in *AccessConfig) DeepCopyInto(out *AccessConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ApprovalConfig != nil {
+		in, out := &in.ApprovalConfig, &out.ApprovalConfig
+		*out = new(ApprovalConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedWindows != nil {
+		in, out := &in.AllowedWindows, &out.AllowedWindows
+		*out = make([]AccessWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessConfig.
@@ -45,6 +151,90 @@ func (in *AccessConfig) DeepCopy() *AccessConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessRule) DeepCopyInto(out *AccessRule) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]AccessRuleResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Verbs != nil {
+		in, out := &in.Verbs, &out.Verbs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRule.
+func (in *AccessRule) DeepCopy() *AccessRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessWindow) DeepCopyInto(out *AccessWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessWindow.
+func (in *AccessWindow) DeepCopy() *AccessWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveRequest) DeepCopyInto(out *ActiveRequest) {
+	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveRequest.
+func (in *ActiveRequest) DeepCopy() *ActiveRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalConfig) DeepCopyInto(out *ApprovalConfig) {
+	*out = *in
+	if in.ApproverGroups != nil {
+		in, out := &in.ApproverGroups, &out.ApproverGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalConfig.
+func (in *ApprovalConfig) DeepCopy() *ApprovalConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreStatus.
 func (in *CoreStatus) DeepCopy() *CoreStatus {
 	if in == nil {
@@ -70,12 +260,213 @@ func (in *CrossVersionObjectReference) DeepCopy() *CrossVersionObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralContainerAccessRequest) DeepCopyInto(out *EphemeralContainerAccessRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralContainerAccessRequest.
+func (in *EphemeralContainerAccessRequest) DeepCopy() *EphemeralContainerAccessRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralContainerAccessRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EphemeralContainerAccessRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralContainerAccessRequestList) DeepCopyInto(out *EphemeralContainerAccessRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EphemeralContainerAccessRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralContainerAccessRequestList.
+func (in *EphemeralContainerAccessRequestList) DeepCopy() *EphemeralContainerAccessRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralContainerAccessRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EphemeralContainerAccessRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralContainerAccessRequestSpec) DeepCopyInto(out *EphemeralContainerAccessRequestSpec) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RequestedBy != nil {
+		in, out := &in.RequestedBy, &out.RequestedBy
+		*out = new(RequesterInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RevokedBy != nil {
+		in, out := &in.RevokedBy, &out.RevokedBy
+		*out = new(RequesterInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralContainerAccessRequestSpec.
+func (in *EphemeralContainerAccessRequestSpec) DeepCopy() *EphemeralContainerAccessRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralContainerAccessRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralContainerAccessRequestStatus) DeepCopyInto(out *EphemeralContainerAccessRequestStatus) {
+	*out = *in
+	in.CoreStatus.DeepCopyInto(&out.CoreStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralContainerAccessRequestStatus.
+func (in *EphemeralContainerAccessRequestStatus) DeepCopy() *EphemeralContainerAccessRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralContainerAccessRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralContainerAccessTemplate) DeepCopyInto(out *EphemeralContainerAccessTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralContainerAccessTemplate.
+func (in *EphemeralContainerAccessTemplate) DeepCopy() *EphemeralContainerAccessTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralContainerAccessTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EphemeralContainerAccessTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralContainerAccessTemplateList) DeepCopyInto(out *EphemeralContainerAccessTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EphemeralContainerAccessTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralContainerAccessTemplateList.
+func (in *EphemeralContainerAccessTemplateList) DeepCopy() *EphemeralContainerAccessTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralContainerAccessTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EphemeralContainerAccessTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralContainerAccessTemplateSpec) DeepCopyInto(out *EphemeralContainerAccessTemplateSpec) {
+	*out = *in
+	in.AccessConfig.DeepCopyInto(&out.AccessConfig)
+	if in.ControllerTargetRef != nil {
+		in, out := &in.ControllerTargetRef, &out.ControllerTargetRef
+		*out = new(CrossVersionObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralContainerAccessTemplateSpec.
+func (in *EphemeralContainerAccessTemplateSpec) DeepCopy() *EphemeralContainerAccessTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralContainerAccessTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralContainerAccessTemplateStatus) DeepCopyInto(out *EphemeralContainerAccessTemplateStatus) {
+	*out = *in
+	in.CoreStatus.DeepCopyInto(&out.CoreStatus)
+	in.TemplateUsage.DeepCopyInto(&out.TemplateUsage)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralContainerAccessTemplateStatus.
+func (in *EphemeralContainerAccessTemplateStatus) DeepCopy() *EphemeralContainerAccessTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralContainerAccessTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecAccessRequest) DeepCopyInto(out *ExecAccessRequest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -111,18 +502,242 @@ func (in *ExecAccessRequestList) DeepCopyInto(out *ExecAccessRequestList) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAccessRequestList.
-func (in *ExecAccessRequestList) DeepCopy() *ExecAccessRequestList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAccessRequestList.
+func (in *ExecAccessRequestList) DeepCopy() *ExecAccessRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecAccessRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExecAccessRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecAccessRequestSpec) DeepCopyInto(out *ExecAccessRequestSpec) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RequestedBy != nil {
+		in, out := &in.RequestedBy, &out.RequestedBy
+		*out = new(RequesterInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RevokedBy != nil {
+		in, out := &in.RevokedBy, &out.RevokedBy
+		*out = new(RequesterInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAccessRequestSpec.
+func (in *ExecAccessRequestSpec) DeepCopy() *ExecAccessRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecAccessRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecAccessRequestStatus) DeepCopyInto(out *ExecAccessRequestStatus) {
+	*out = *in
+	in.CoreStatus.DeepCopyInto(&out.CoreStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAccessRequestStatus.
+func (in *ExecAccessRequestStatus) DeepCopy() *ExecAccessRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecAccessRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecAccessTemplate) DeepCopyInto(out *ExecAccessTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAccessTemplate.
+func (in *ExecAccessTemplate) DeepCopy() *ExecAccessTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecAccessTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExecAccessTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecAccessTemplateList) DeepCopyInto(out *ExecAccessTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExecAccessTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAccessTemplateList.
+func (in *ExecAccessTemplateList) DeepCopy() *ExecAccessTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecAccessTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExecAccessTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecAccessTemplateSpec) DeepCopyInto(out *ExecAccessTemplateSpec) {
+	*out = *in
+	in.AccessConfig.DeepCopyInto(&out.AccessConfig)
+	if in.ControllerTargetRef != nil {
+		in, out := &in.ControllerTargetRef, &out.ControllerTargetRef
+		*out = new(CrossVersionObjectReference)
+		**out = **in
+	}
+	if in.AccessRules != nil {
+		in, out := &in.AccessRules, &out.AccessRules
+		*out = make([]AccessRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAccessTemplateSpec.
+func (in *ExecAccessTemplateSpec) DeepCopy() *ExecAccessTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecAccessTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecAccessTemplateStatus) DeepCopyInto(out *ExecAccessTemplateStatus) {
+	*out = *in
+	in.CoreStatus.DeepCopyInto(&out.CoreStatus)
+	in.TemplateUsage.DeepCopyInto(&out.TemplateUsage)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAccessTemplateStatus.
+func (in *ExecAccessTemplateStatus) DeepCopy() *ExecAccessTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecAccessTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONPatchOperation) DeepCopyInto(out *JSONPatchOperation) {
+	*out = *in
+	out.Value = in.Value
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONPatchOperation.
+func (in *JSONPatchOperation) DeepCopy() *JSONPatchOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONPatchOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogAccessRequest) DeepCopyInto(out *LogAccessRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogAccessRequest.
+func (in *LogAccessRequest) DeepCopy() *LogAccessRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LogAccessRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogAccessRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogAccessRequestList) DeepCopyInto(out *LogAccessRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LogAccessRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogAccessRequestList.
+func (in *LogAccessRequestList) DeepCopy() *LogAccessRequestList {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecAccessRequestList)
+	out := new(LogAccessRequestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ExecAccessRequestList) DeepCopyObject() runtime.Object {
+func (in *LogAccessRequestList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -130,38 +745,57 @@ func (in *ExecAccessRequestList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecAccessRequestSpec) DeepCopyInto(out *ExecAccessRequestSpec) {
+func (in *LogAccessRequestSpec) DeepCopyInto(out *LogAccessRequestSpec) {
 	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RequestedBy != nil {
+		in, out := &in.RequestedBy, &out.RequestedBy
+		*out = new(RequesterInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RevokedBy != nil {
+		in, out := &in.RevokedBy, &out.RevokedBy
+		*out = new(RequesterInfo)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAccessRequestSpec.
-func (in *ExecAccessRequestSpec) DeepCopy() *ExecAccessRequestSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogAccessRequestSpec.
+func (in *LogAccessRequestSpec) DeepCopy() *LogAccessRequestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecAccessRequestSpec)
+	out := new(LogAccessRequestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecAccessRequestStatus) DeepCopyInto(out *ExecAccessRequestStatus) {
+func (in *LogAccessRequestStatus) DeepCopyInto(out *LogAccessRequestStatus) {
 	*out = *in
 	in.CoreStatus.DeepCopyInto(&out.CoreStatus)
+	if in.PodNames != nil {
+		in, out := &in.PodNames, &out.PodNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAccessRequestStatus.
-func (in *ExecAccessRequestStatus) DeepCopy() *ExecAccessRequestStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogAccessRequestStatus.
+func (in *LogAccessRequestStatus) DeepCopy() *LogAccessRequestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecAccessRequestStatus)
+	out := new(LogAccessRequestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecAccessTemplate) DeepCopyInto(out *ExecAccessTemplate) {
+func (in *LogAccessTemplate) DeepCopyInto(out *LogAccessTemplate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -169,18 +803,18 @@ func (in *ExecAccessTemplate) DeepCopyInto(out *ExecAccessTemplate) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAccessTemplate.
-func (in *ExecAccessTemplate) DeepCopy() *ExecAccessTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogAccessTemplate.
+func (in *LogAccessTemplate) DeepCopy() *LogAccessTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecAccessTemplate)
+	out := new(LogAccessTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ExecAccessTemplate) DeepCopyObject() runtime.Object {
+func (in *LogAccessTemplate) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -188,31 +822,31 @@ func (in *ExecAccessTemplate) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecAccessTemplateList) DeepCopyInto(out *ExecAccessTemplateList) {
+func (in *LogAccessTemplateList) DeepCopyInto(out *LogAccessTemplateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ExecAccessTemplate, len(*in))
+		*out = make([]LogAccessTemplate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAccessTemplateList.
-func (in *ExecAccessTemplateList) DeepCopy() *ExecAccessTemplateList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogAccessTemplateList.
+func (in *LogAccessTemplateList) DeepCopy() *LogAccessTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecAccessTemplateList)
+	out := new(LogAccessTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ExecAccessTemplateList) DeepCopyObject() runtime.Object {
+func (in *LogAccessTemplateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -220,7 +854,7 @@ func (in *ExecAccessTemplateList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecAccessTemplateSpec) DeepCopyInto(out *ExecAccessTemplateSpec) {
+func (in *LogAccessTemplateSpec) DeepCopyInto(out *LogAccessTemplateSpec) {
 	*out = *in
 	in.AccessConfig.DeepCopyInto(&out.AccessConfig)
 	if in.ControllerTargetRef != nil {
@@ -230,44 +864,29 @@ func (in *ExecAccessTemplateSpec) DeepCopyInto(out *ExecAccessTemplateSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAccessTemplateSpec.
-func (in *ExecAccessTemplateSpec) DeepCopy() *ExecAccessTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogAccessTemplateSpec.
+func (in *LogAccessTemplateSpec) DeepCopy() *LogAccessTemplateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecAccessTemplateSpec)
+	out := new(LogAccessTemplateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecAccessTemplateStatus) DeepCopyInto(out *ExecAccessTemplateStatus) {
+func (in *LogAccessTemplateStatus) DeepCopyInto(out *LogAccessTemplateStatus) {
 	*out = *in
 	in.CoreStatus.DeepCopyInto(&out.CoreStatus)
+	in.TemplateUsage.DeepCopyInto(&out.TemplateUsage)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAccessTemplateStatus.
-func (in *ExecAccessTemplateStatus) DeepCopy() *ExecAccessTemplateStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(ExecAccessTemplateStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JSONPatchOperation) DeepCopyInto(out *JSONPatchOperation) {
-	*out = *in
-	out.Value = in.Value
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONPatchOperation.
-func (in *JSONPatchOperation) DeepCopy() *JSONPatchOperation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogAccessTemplateStatus.
+func (in *LogAccessTemplateStatus) DeepCopy() *LogAccessTemplateStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(JSONPatchOperation)
+	out := new(LogAccessTemplateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -277,7 +896,7 @@ func (in *PodAccessRequest) DeepCopyInto(out *PodAccessRequest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -334,6 +953,25 @@ func (in *PodAccessRequestList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodAccessRequestSpec) DeepCopyInto(out *PodAccessRequestSpec) {
 	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RequestedBy != nil {
+		in, out := &in.RequestedBy, &out.RequestedBy
+		*out = new(RequesterInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RevokedBy != nil {
+		in, out := &in.RevokedBy, &out.RevokedBy
+		*out = new(RequesterInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodAccessRequestSpec.
@@ -350,6 +988,11 @@ func (in *PodAccessRequestSpec) DeepCopy() *PodAccessRequestSpec {
 func (in *PodAccessRequestStatus) DeepCopyInto(out *PodAccessRequestStatus) {
 	*out = *in
 	in.CoreStatus.DeepCopyInto(&out.CoreStatus)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodAccessRequestStatus.
@@ -435,6 +1078,13 @@ func (in *PodAccessTemplateSpec) DeepCopyInto(out *PodAccessTemplateSpec) {
 		*out = new(PodTemplateSpecMutationConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AccessRules != nil {
+		in, out := &in.AccessRules, &out.AccessRules
+		*out = make([]AccessRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.PodSpec != nil {
 		in, out := &in.PodSpec, &out.PodSpec
 		*out = new(v1.PodSpec)
@@ -459,6 +1109,7 @@ func (in *PodAccessTemplateSpec) DeepCopy() *PodAccessTemplateSpec {
 func (in *PodAccessTemplateStatus) DeepCopyInto(out *PodAccessTemplateStatus) {
 	*out = *in
 	in.CoreStatus.DeepCopyInto(&out.CoreStatus)
+	in.TemplateUsage.DeepCopyInto(&out.TemplateUsage)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodAccessTemplateStatus.
@@ -549,3 +1200,260 @@ func (in *PodTemplateSpecMutationConfig) DeepCopy() *PodTemplateSpecMutationConf
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortForwardAccessRequest) DeepCopyInto(out *PortForwardAccessRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortForwardAccessRequest.
+func (in *PortForwardAccessRequest) DeepCopy() *PortForwardAccessRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(PortForwardAccessRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PortForwardAccessRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortForwardAccessRequestList) DeepCopyInto(out *PortForwardAccessRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PortForwardAccessRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortForwardAccessRequestList.
+func (in *PortForwardAccessRequestList) DeepCopy() *PortForwardAccessRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(PortForwardAccessRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PortForwardAccessRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortForwardAccessRequestSpec) DeepCopyInto(out *PortForwardAccessRequestSpec) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RequestedBy != nil {
+		in, out := &in.RequestedBy, &out.RequestedBy
+		*out = new(RequesterInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RevokedBy != nil {
+		in, out := &in.RevokedBy, &out.RevokedBy
+		*out = new(RequesterInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortForwardAccessRequestSpec.
+func (in *PortForwardAccessRequestSpec) DeepCopy() *PortForwardAccessRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PortForwardAccessRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortForwardAccessRequestStatus) DeepCopyInto(out *PortForwardAccessRequestStatus) {
+	*out = *in
+	in.CoreStatus.DeepCopyInto(&out.CoreStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortForwardAccessRequestStatus.
+func (in *PortForwardAccessRequestStatus) DeepCopy() *PortForwardAccessRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PortForwardAccessRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortForwardAccessTemplate) DeepCopyInto(out *PortForwardAccessTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortForwardAccessTemplate.
+func (in *PortForwardAccessTemplate) DeepCopy() *PortForwardAccessTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(PortForwardAccessTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PortForwardAccessTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortForwardAccessTemplateList) DeepCopyInto(out *PortForwardAccessTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PortForwardAccessTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortForwardAccessTemplateList.
+func (in *PortForwardAccessTemplateList) DeepCopy() *PortForwardAccessTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(PortForwardAccessTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PortForwardAccessTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortForwardAccessTemplateSpec) DeepCopyInto(out *PortForwardAccessTemplateSpec) {
+	*out = *in
+	in.AccessConfig.DeepCopyInto(&out.AccessConfig)
+	if in.ControllerTargetRef != nil {
+		in, out := &in.ControllerTargetRef, &out.ControllerTargetRef
+		*out = new(CrossVersionObjectReference)
+		**out = **in
+	}
+	if in.AllowedPorts != nil {
+		in, out := &in.AllowedPorts, &out.AllowedPorts
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortForwardAccessTemplateSpec.
+func (in *PortForwardAccessTemplateSpec) DeepCopy() *PortForwardAccessTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PortForwardAccessTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortForwardAccessTemplateStatus) DeepCopyInto(out *PortForwardAccessTemplateStatus) {
+	*out = *in
+	in.CoreStatus.DeepCopyInto(&out.CoreStatus)
+	in.TemplateUsage.DeepCopyInto(&out.TemplateUsage)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortForwardAccessTemplateStatus.
+func (in *PortForwardAccessTemplateStatus) DeepCopy() *PortForwardAccessTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PortForwardAccessTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequesterInfo) DeepCopyInto(out *RequesterInfo) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequesterInfo.
+func (in *RequesterInfo) DeepCopy() *RequesterInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(RequesterInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateUsage) DeepCopyInto(out *TemplateUsage) {
+	*out = *in
+	if in.ActiveRequests != nil {
+		in, out := &in.ActiveRequests, &out.ActiveRequests
+		*out = make([]ActiveRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUsedTime != nil {
+		in, out := &in.LastUsedTime, &out.LastUsedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateUsage.
+func (in *TemplateUsage) DeepCopy() *TemplateUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateUsage)
+	in.DeepCopyInto(out)
+	return out
+}
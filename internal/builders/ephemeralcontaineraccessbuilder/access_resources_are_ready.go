@@ -0,0 +1,61 @@
+package ephemeralcontaineraccessbuilder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+// AccessResourcesAreReady implements the IBuilder interface by checking
+// whether the injected debug container is running yet. If it is not, the
+// RequestReconciler requeues and checks again shortly.
+func (b *EphemeralContainerAccessBuilder) AccessResourcesAreReady(
+	ctx context.Context,
+	client client.Client,
+	req v1alpha1.IRequestResource,
+	_ v1alpha1.ITemplateResource,
+) (bool, error) {
+	// Cast the Request into an EphemeralContainerAccessRequest.
+	ecReq := req.(*v1alpha1.EphemeralContainerAccessRequest)
+
+	// First, verify whether or not the PodName and ContainerName fields have
+	// been set. If not, then some part of the reconciliation has previously
+	// failed.
+	if ecReq.GetPodName() == "" || ecReq.GetContainerName() == "" {
+		return false, errors.New("status.podName or status.containerName not yet set")
+	}
+
+	pod := &corev1.Pod{}
+	if err := client.Get(ctx, types.NamespacedName{
+		Name:      ecReq.GetPodName(),
+		Namespace: ecReq.GetNamespace(),
+	}, pod); err != nil {
+		return false, err
+	}
+
+	return isContainerRunning(pod, ecReq.GetContainerName())
+}
+
+// isContainerRunning returns true once the named ephemeral container is
+// running, or an error if it has already terminated.
+func isContainerRunning(pod *corev1.Pod, containerName string) (bool, error) {
+	for _, status := range pod.Status.EphemeralContainerStatuses {
+		if status.Name != containerName {
+			continue
+		}
+		if status.State.Terminated != nil {
+			return false, fmt.Errorf(
+				"debug container %s has terminated: %s",
+				containerName, status.State.Terminated.Reason,
+			)
+		}
+		return status.State.Running != nil, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,142 @@
+package ephemeralcontaineraccessbuilder
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/builders/internal/podselection"
+	bldutil "github.com/diranged/oz/internal/builders/utils"
+)
+
+// accessRules are the permissions granted on the target pod. The user can
+// attach to (or exec into) the debug container, but is not granted
+// "pods/ephemeralcontainers" - so they cannot inject containers of their own.
+var accessRules = []v1alpha1.AccessRule{
+	{
+		Resources: []v1alpha1.AccessRuleResource{"pods"},
+		Verbs:     []string{"get"},
+	},
+	{
+		Resources: []v1alpha1.AccessRuleResource{"pods/attach", "pods/exec"},
+		Verbs:     []string{"create", "get"},
+	},
+}
+
+// CreateAccessResources implements the IBuilder interface
+func (b *EphemeralContainerAccessBuilder) CreateAccessResources(
+	ctx context.Context,
+	client client.Client,
+	req v1alpha1.IRequestResource,
+	tmpl v1alpha1.ITemplateResource,
+) (statusString string, err error) {
+	// Cast the Request into an EphemeralContainerAccessRequest.
+	ecReq := req.(*v1alpha1.EphemeralContainerAccessRequest)
+	// Cast the Template into an EphemeralContainerAccessTemplate.
+	ecTmpl := tmpl.(*v1alpha1.EphemeralContainerAccessTemplate)
+
+	// Get the target Pod Name that the user is going to have access to
+	targetPod, err := podselection.GetPod(ctx, client, ecReq, ecTmpl, ecReq.Spec.TargetPod)
+	if err != nil {
+		return statusString, err
+	}
+
+	// Inject the debug container into the target Pod, unless a previous
+	// reconcile already did it.
+	containerName := debugContainerName(ecReq)
+	if err := injectDebugContainer(ctx, client, targetPod, ecTmpl, containerName); err != nil {
+		return statusString, err
+	}
+
+	// Define the permissions the access request will grant, scoped to the
+	// target pod.
+	rules, err := bldutil.GeneratePolicyRules(accessRules, targetPod.GetName())
+	if err != nil {
+		return statusString, err
+	}
+
+	// Get the Role, or error out
+	role, err := bldutil.CreateRole(ctx, client, ecReq, rules)
+	if err != nil {
+		return statusString, err
+	}
+
+	// Get the Binding, or error out
+	rb, err := bldutil.CreateRoleBinding(ctx, client, ecReq, tmpl, role)
+	if err != nil {
+		return statusString, err
+	}
+
+	ecReq.Status.SetAccessMessage(fmt.Sprintf(
+		"kubectl attach -ti -n %s %s -c %s",
+		targetPod.GetNamespace(), targetPod.GetName(), containerName,
+	))
+
+	// Record the selected pod and container so that subsequent reconciles
+	// always use the same ones.
+	if err := ecReq.SetPodName(targetPod.GetName()); err != nil {
+		return "", err
+	}
+	if err := ecReq.SetContainerName(containerName); err != nil {
+		return "", err
+	}
+
+	// We've been mutating the ecReq Status throughout this build. Need to
+	// push the update back to the cluster here.
+	if err := client.Status().Update(ctx, ecReq); err != nil {
+		return "", err
+	}
+
+	statusString = fmt.Sprintf(
+		"Success. Container %s injected, Role %s, RoleBinding %s created",
+		containerName, role.Name, rb.Name,
+	)
+	return statusString, nil
+}
+
+// debugContainerName returns the name of the ephemeral container for the
+// request. Container names are limited to 63 characters, so the (potentially
+// long) request name is not used here.
+func debugContainerName(req client.Object) string {
+	if shortUID := bldutil.GetShortUID(req); shortUID != "" {
+		return fmt.Sprintf("oz-debug-%s", shortUID)
+	}
+	return "oz-debug"
+}
+
+// injectDebugContainer adds the debug container to the Pod through the
+// "pods/ephemeralcontainers" subresource. Ephemeral containers can never be
+// removed from a Pod, so this is a no-op if the container already exists.
+func injectDebugContainer(
+	ctx context.Context,
+	cl client.Client,
+	pod *corev1.Pod,
+	tmpl *v1alpha1.EphemeralContainerAccessTemplate,
+	containerName string,
+) error {
+	log := logf.FromContext(ctx)
+
+	for _, c := range pod.Spec.EphemeralContainers {
+		if c.Name == containerName {
+			log.V(1).Info(fmt.Sprintf("Debug container %s already exists", containerName))
+			return nil
+		}
+	}
+
+	log.Info(fmt.Sprintf("Injecting debug container %s into pod %s", containerName, pod.GetName()))
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name:                     containerName,
+			Image:                    tmpl.Spec.DebugImage,
+			Stdin:                    true,
+			TTY:                      true,
+			TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+		},
+		TargetContainerName: tmpl.Spec.TargetContainerName,
+	})
+	return cl.SubResource("ephemeralcontainers").Update(ctx, pod)
+}
@@ -0,0 +1,268 @@
+package ephemeralcontaineraccessbuilder
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/builders/internal/podselection"
+	bldutil "github.com/diranged/oz/internal/builders/utils"
+	"github.com/diranged/oz/internal/testing/utils"
+)
+
+var _ = Describe("EphemeralContainerAccessBuilder", Ordered, func() {
+	Context("CreateAccessResources()", func() {
+		var (
+			ctx        = context.Background()
+			ns         *corev1.Namespace
+			deployment *appsv1.Deployment
+			pod        *corev1.Pod
+			request    *v1alpha1.EphemeralContainerAccessRequest
+			template   *v1alpha1.EphemeralContainerAccessTemplate
+			builder    = EphemeralContainerAccessBuilder{}
+		)
+
+		// For Envtest
+		podselection.PodPhaseRunning = "Pending"
+
+		BeforeAll(func() {
+			By("Should have a namespace to execute tests in")
+			ns = &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: testutil.RandomString(8),
+				},
+			}
+			err := k8sClient.Create(ctx, ns)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Creating a Deployment to reference for the test")
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(4),
+					Namespace: ns.Name,
+				},
+				Spec: appsv1.DeploymentSpec{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"testLabel": "testValue",
+						},
+					},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								"testLabel": "testValue",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name:  "test",
+									Image: "nginx:latest",
+								},
+							},
+						},
+					},
+				},
+			}
+			err = k8sClient.Create(ctx, deployment)
+			Expect(err).To(Not(HaveOccurred()))
+
+			By("Create a single Pod that should match the Deployment spec above for testing")
+			pod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+					Labels:    deployment.Spec.Selector.MatchLabels,
+				},
+				Spec: deployment.Spec.Template.Spec,
+				Status: corev1.PodStatus{
+					Phase: "Running",
+				},
+			}
+			err = k8sClient.Create(ctx, pod)
+			Expect(err).To(Not(HaveOccurred()))
+
+			By("Should have an EphemeralContainerAccessTemplate to test against")
+			template = &v1alpha1.EphemeralContainerAccessTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.EphemeralContainerAccessTemplateSpec{
+					AccessConfig: v1alpha1.AccessConfig{
+						AllowedGroups:   []string{"foo"},
+						DefaultDuration: "1h",
+						MaxDuration:     "2h",
+					},
+					ControllerTargetRef: &v1alpha1.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       deployment.GetName(),
+					},
+					DebugImage:          "busybox:latest",
+					TargetContainerName: "test",
+				},
+			}
+			err = k8sClient.Create(ctx, template)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Should have an EphemeralContainerAccessRequest built to test against")
+			request = &v1alpha1.EphemeralContainerAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "createaccessresource-test",
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.EphemeralContainerAccessRequestSpec{
+					TemplateName: template.GetName(),
+				},
+			}
+			err = k8sClient.Create(ctx, request)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterAll(func() {
+			By("Should delete the namespace")
+			err := k8sClient.Delete(ctx, ns)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("CreateAccessResources() should fail if pod is missing", func() {
+			request.Spec.TargetPod = "testPod"
+			_, err := builder.CreateAccessResources(ctx, k8sClient, request, template)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(MatchRegexp("not found"))
+		})
+
+		It("CreateAccessResources() should inject the debug container", func() {
+			request.Spec.TargetPod = ""
+
+			ret, err := builder.CreateAccessResources(ctx, k8sClient, request, template)
+
+			// VERIFY: No error returned
+			Expect(err).ToNot(HaveOccurred())
+
+			// VERIFY: Proper status string returned
+			containerName := debugContainerName(request)
+			Expect(ret).To(MatchRegexp(fmt.Sprintf(
+				"Success. Container %s injected, Role %s-.*, RoleBinding %s.* created",
+				containerName,
+				request.GetName(),
+				request.GetName(),
+			)))
+
+			// VERIFY: The pod and container names were recorded
+			Expect(request.GetPodName()).To(Equal(pod.GetName()))
+			Expect(request.GetContainerName()).To(Equal(containerName))
+			Expect(request.Status.AccessMessage).To(Equal(fmt.Sprintf(
+				"kubectl attach -ti -n %s %s -c %s",
+				ns.GetName(),
+				pod.GetName(),
+				containerName,
+			)))
+
+			// VERIFY: The ephemeral container was added to the pod
+			foundPod := &corev1.Pod{}
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      pod.GetName(),
+				Namespace: ns.GetName(),
+			}, foundPod)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundPod.Spec.EphemeralContainers).To(HaveLen(1))
+			Expect(foundPod.Spec.EphemeralContainers[0].Name).To(Equal(containerName))
+			Expect(foundPod.Spec.EphemeralContainers[0].Image).To(Equal("busybox:latest"))
+			Expect(foundPod.Spec.EphemeralContainers[0].TargetContainerName).To(Equal("test"))
+
+			// VERIFY: Role Created as expected
+			foundRole := &rbacv1.Role{}
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      bldutil.GenerateResourceName(request),
+				Namespace: ns.GetName(),
+			}, foundRole)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundRole.Rules).To(HaveLen(2))
+			Expect(foundRole.Rules[1].Resources).To(Equal([]string{"pods/attach", "pods/exec"}))
+			Expect(foundRole.Rules[1].ResourceNames[0]).To(Equal(pod.GetName()))
+		})
+
+		It("CreateAccessResources() should not inject a second container", func() {
+			_, err := builder.CreateAccessResources(ctx, k8sClient, request, template)
+			Expect(err).ToNot(HaveOccurred())
+
+			foundPod := &corev1.Pod{}
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      pod.GetName(),
+				Namespace: ns.GetName(),
+			}, foundPod)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundPod.Spec.EphemeralContainers).To(HaveLen(1))
+		})
+
+		It("AccessResourcesAreReady() should wait for the container to start", func() {
+			ready, err := builder.AccessResourcesAreReady(ctx, k8sClient, request, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ready).To(BeFalse())
+		})
+
+		It("debugContainerName() should not panic on a missing or short UID", func() {
+			Expect(debugContainerName(request)).To(Equal(
+				fmt.Sprintf("oz-debug-%s", string(request.GetUID())[0:8]),
+			))
+			Expect(debugContainerName(&v1alpha1.EphemeralContainerAccessRequest{})).To(Equal("oz-debug"))
+
+			short := &v1alpha1.EphemeralContainerAccessRequest{}
+			short.SetUID("abc")
+			Expect(debugContainerName(short)).To(Equal("oz-debug-abc"))
+		})
+	})
+
+	Context("isContainerRunning()", func() {
+		podWith := func(state corev1.ContainerState) *corev1.Pod {
+			return &corev1.Pod{
+				Status: corev1.PodStatus{
+					EphemeralContainerStatuses: []corev1.ContainerStatus{
+						{Name: "other", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+						{Name: "debug", State: state},
+					},
+				},
+			}
+		}
+
+		It("isContainerRunning() should be false until the container is reported", func() {
+			running, err := isContainerRunning(&corev1.Pod{}, "debug")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(running).To(BeFalse())
+		})
+
+		It("isContainerRunning() should be false while the container is waiting", func() {
+			running, err := isContainerRunning(podWith(corev1.ContainerState{
+				Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"},
+			}), "debug")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(running).To(BeFalse())
+		})
+
+		It("isContainerRunning() should be true once the container runs", func() {
+			running, err := isContainerRunning(podWith(corev1.ContainerState{
+				Running: &corev1.ContainerStateRunning{},
+			}), "debug")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(running).To(BeTrue())
+		})
+
+		It("isContainerRunning() should fail if the container terminated", func() {
+			_, err := isContainerRunning(podWith(corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{Reason: "Error"},
+			}), "debug")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,16 @@
+package ephemeralcontaineraccessbuilder
+
+import (
+	"time"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	bldutil "github.com/diranged/oz/internal/builders/utils"
+)
+
+// GetAccessDuration implements the IBuilder interface
+func (b *EphemeralContainerAccessBuilder) GetAccessDuration(
+	req v1alpha1.IRequestResource,
+	tmpl v1alpha1.ITemplateResource,
+) (time.Duration, string, error) {
+	return bldutil.GetAccessDuration(req, tmpl)
+}
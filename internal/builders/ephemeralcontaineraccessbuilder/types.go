@@ -0,0 +1,24 @@
+// Package ephemeralcontaineraccessbuilder implements the IBuilder interface for EphemeralContainerAccessRequest resources
+package ephemeralcontaineraccessbuilder
+
+import (
+	"github.com/diranged/oz/internal/builders"
+)
+
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=ephemeralcontaineraccessrequests,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=ephemeralcontaineraccessrequests/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=ephemeralcontaineraccessrequests/finalizers,verbs=update
+
+//+kubebuilder:rbac:groups="",resources=pods/ephemeralcontainers,verbs=get;update;patch
+
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete;bind;escalate
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// EphemeralContainerAccessBuilder implements the IBuilder interface for EphemeralContainerAccessRequest resources
+type EphemeralContainerAccessBuilder struct{}
+
+// https://stackoverflow.com/questions/33089523/how-to-mark-golang-struct-as-implementing-interface
+var (
+	_ builders.IBuilder = &EphemeralContainerAccessBuilder{}
+	_ builders.IBuilder = (*EphemeralContainerAccessBuilder)(nil)
+)
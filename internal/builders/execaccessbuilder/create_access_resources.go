@@ -4,12 +4,10 @@ import (
 	"context"
 	"fmt"
 
-	corev1 "k8s.io/api/core/v1"
-	rbacv1 "k8s.io/api/rbac/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/diranged/oz/internal/api/v1alpha1"
-	"github.com/diranged/oz/internal/builders/execaccessbuilder/internal"
+	"github.com/diranged/oz/internal/builders/internal/podselection"
 	bldutil "github.com/diranged/oz/internal/builders/utils"
 )
 
@@ -26,27 +24,16 @@ func (b *ExecAccessBuilder) CreateAccessResources(
 	execTmpl := tmpl.(*v1alpha1.ExecAccessTemplate)
 
 	// Get the target Pod Name that the user is going to have access to
-	targetPod, err := podselection.GetPod(ctx, client, execReq, execTmpl)
+	targetPod, err := podselection.GetPod(ctx, client, execReq, execTmpl, execReq.Spec.TargetPod)
 	if err != nil {
 		return statusString, err
 	}
 
-	// Define the permissions the access request will grant.
-	//
-	// TODO: Implement the ability to tune this in the ExecAccessTemplate settings.
-	rules := []rbacv1.PolicyRule{
-		{
-			APIGroups:     []string{corev1.GroupName},
-			Resources:     []string{"pods"},
-			ResourceNames: []string{targetPod.Name},
-			Verbs:         []string{"get", "list", "watch"},
-		},
-		{
-			APIGroups:     []string{corev1.GroupName},
-			Resources:     []string{"pods/exec"},
-			ResourceNames: []string{targetPod.Name},
-			Verbs:         []string{"create", "update", "delete", "get", "list"},
-		},
+	// Define the permissions the access request will grant, scoped to the
+	// target pod.
+	rules, err := bldutil.GeneratePolicyRules(execTmpl.GetAccessRules(), targetPod.Name)
+	if err != nil {
+		return statusString, err
 	}
 
 	// Get the Role, or error out
@@ -1,4 +0,0 @@
-// Package podselection contains pod selection logic for the execaccessbuilder.
-// This is in an internal/ directory to prevent external imports while keeping
-// the builder interface clean.
-package podselection
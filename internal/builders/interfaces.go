@@ -60,3 +60,16 @@ type IBuilder interface {
 		tmpl v1alpha1.ITemplateResource,
 	) (bool, error)
 }
+
+// IPodWatchingBuilder is an optional interface for builders whose access
+// resources depend on the set of Pods that currently belong to the target
+// controller (rather than on a single selected Pod). The RequestController
+// watches Pods for these builders, and reconciles the Access Requests in a
+// Pod's namespace whenever one of its Pods is created, deleted or relabelled so
+// that the access resources can follow the Pod churn.
+type IPodWatchingBuilder interface {
+	IBuilder
+
+	// WatchesPods is a marker method - it does nothing.
+	WatchesPods()
+}
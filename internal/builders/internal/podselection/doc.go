@@ -0,0 +1,5 @@
+// Package podselection contains the pod selection logic shared by the builders
+// that grant access to existing Pods (eg. execaccessbuilder). This is in an
+// internal/ directory to prevent external imports while keeping the builder
+// interface clean.
+package podselection
@@ -16,6 +16,10 @@ import (
 // function is designed to be idempotent - so once a pod name has been selected, it will be used on
 // each and every reconcile going forward.
 //
+// If the targetPod is supplied, that specific Pod is used (as long as it
+// belongs to the template's target controller). Otherwise a random running Pod
+// is selected.
+//
 // Returns:
 //
 //	pod: *corev1.Pod of an existing pod (or nil in a failure)
@@ -23,8 +27,9 @@ import (
 func GetPod(
 	ctx context.Context,
 	client client.Client,
-	req *v1alpha1.ExecAccessRequest,
-	tmpl *v1alpha1.ExecAccessTemplate,
+	req v1alpha1.IPodRequestResource,
+	tmpl v1alpha1.ITemplateResource,
+	targetPod string,
 ) (pod *corev1.Pod, err error) {
 	log := logf.FromContext(ctx)
 	var p *corev1.Pod
@@ -48,15 +53,15 @@ func GetPod(
 
 	// If the user supplied their own Pod, then get that Pod back to make sure
 	// it exists. Otherwise, randomly select a pod.
-	switch req.Spec.TargetPod {
+	switch targetPod {
 	case "":
 		p, err = getRandomPod(ctx, client, tmpl)
 		if err != nil {
-			log.Error(err, "Failed to retrieve Pod from ExecAccessTemplate")
+			log.Error(err, "Failed to retrieve Pod from Access Template")
 			return nil, err
 		}
 	default:
-		p, err = getSpecificPod(ctx, client, req.Spec.TargetPod, tmpl)
+		p, err = getSpecificPod(ctx, client, targetPod, tmpl)
 
 		// Informative for the operator for now. The verification step below
 		// truly let the user know about the problem.
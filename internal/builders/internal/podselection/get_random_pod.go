@@ -16,7 +16,7 @@ import (
 func getRandomPod(
 	ctx context.Context,
 	cl client.Client,
-	tmpl *v1alpha1.ExecAccessTemplate,
+	tmpl v1alpha1.ITemplateResource,
 ) (*corev1.Pod, error) {
 	log := logf.FromContext(ctx)
 	log.Info("Finding Pods...")
@@ -32,7 +32,7 @@ func getRandomPod(
 	// Selector.
 	podList := &corev1.PodList{}
 	opts := []client.ListOption{
-		client.InNamespace(tmpl.Namespace),
+		client.InNamespace(tmpl.GetNamespace()),
 		client.MatchingLabelsSelector{
 			Selector: selector,
 		},
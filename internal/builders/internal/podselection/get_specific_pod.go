@@ -16,7 +16,7 @@ func getSpecificPod(
 	ctx context.Context,
 	cl client.Client,
 	podName string,
-	tmpl *v1alpha1.ExecAccessTemplate,
+	tmpl v1alpha1.ITemplateResource,
 ) (*corev1.Pod, error) {
 	log := logf.FromContext(ctx)
 	log.Info(fmt.Sprintf("Looking for Pod %s", podName))
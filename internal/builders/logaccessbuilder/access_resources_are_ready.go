@@ -0,0 +1,22 @@
+package logaccessbuilder
+
+import (
+	"context"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AccessResourcesAreReady implements the IBuilder interface. The Role is
+// available as soon as it is created, but it only grants access once the
+// target controller has Pods. Until then the request is requeued - and the
+// Pod watch reconciles it again as soon as the Pods show up.
+func (b *LogAccessBuilder) AccessResourcesAreReady(
+	_ context.Context,
+	_ client.Client,
+	req v1alpha1.IRequestResource,
+	_ v1alpha1.ITemplateResource,
+) (bool, error) {
+	logReq := req.(*v1alpha1.LogAccessRequest)
+	return len(logReq.Status.PodNames) > 0, nil
+}
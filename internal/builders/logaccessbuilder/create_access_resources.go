@@ -0,0 +1,161 @@
+package logaccessbuilder
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	bldutil "github.com/diranged/oz/internal/builders/utils"
+)
+
+// accessRules are the permissions granted on each Pod of the target
+// controller. No exec, attach or port-forward access is granted.
+var accessRules = []v1alpha1.AccessRule{
+	{
+		Resources: []v1alpha1.AccessRuleResource{"pods", "pods/log"},
+		Verbs:     []string{"get"},
+	},
+}
+
+// listPodsRule allows "kubectl logs -l ..." to find the Pods matching the
+// label selector. Kubernetes RBAC cannot restrict a "list" call to specific
+// resource names, so this rule necessarily covers every Pod in the namespace -
+// it is only granted when the template sets Spec.allowPodListing. It does not
+// grant access to the logs of those other Pods.
+var listPodsRule = rbacv1.PolicyRule{
+	APIGroups: []string{corev1.GroupName},
+	Resources: []string{"pods"},
+	Verbs:     []string{"list"},
+}
+
+// CreateAccessResources implements the IBuilder interface
+func (b *LogAccessBuilder) CreateAccessResources(
+	ctx context.Context,
+	client client.Client,
+	req v1alpha1.IRequestResource,
+	tmpl v1alpha1.ITemplateResource,
+) (statusString string, err error) {
+	// Cast the Request into a LogAccessRequest, and the Template into a
+	// LogAccessTemplate.
+	logReq := req.(*v1alpha1.LogAccessRequest)
+	logTmpl := tmpl.(*v1alpha1.LogAccessTemplate)
+
+	// Find the label selector of the target controller, and all of the Pods
+	// that currently match it.
+	selector, err := bldutil.GetSelectorLabels(ctx, client, tmpl)
+	if err != nil {
+		return statusString, err
+	}
+	podNames, err := getPodNames(ctx, client, tmpl.GetNamespace(), selector)
+	if err != nil {
+		return statusString, err
+	}
+
+	// Define the permissions the access request will grant, scoped to the
+	// Pods we found. With no Pods (eg. the controller is scaled to zero, or
+	// its Pods are being replaced) the Role grants nothing until they come
+	// back - AccessResourcesAreReady() holds the request back until then.
+	rules := []rbacv1.PolicyRule{}
+	accessMessage := fmt.Sprintf(
+		"No Pods match selector %q yet - access is granted once they exist",
+		selector.String(),
+	)
+	if len(podNames) > 0 {
+		rules, err = bldutil.GeneratePolicyRulesForPods(accessRules, podNames)
+		if err != nil {
+			return statusString, err
+		}
+		accessMessage = podLogsCommand(tmpl.GetNamespace(), podNames)
+		if logTmpl.Spec.AllowPodListing {
+			rules = append(rules, listPodsRule)
+			accessMessage = logsCommand(tmpl.GetNamespace(), selector)
+		}
+	}
+
+	// Get the Role, or error out. The Role is updated in place when the Pods
+	// of the target controller change - otherwise this is a no-op.
+	role, err := bldutil.CreateRole(ctx, client, logReq, rules)
+	if err != nil {
+		return statusString, err
+	}
+
+	// Get the Binding, or error out
+	rb, err := bldutil.CreateRoleBinding(ctx, client, logReq, tmpl, role)
+	if err != nil {
+		return statusString, err
+	}
+
+	// Push the access message and Pod names back to the cluster - unless
+	// they are unchanged since the last reconcile.
+	if logReq.Status.AccessMessage != accessMessage || !slices.Equal(logReq.Status.PodNames, podNames) {
+		logReq.Status.SetAccessMessage(accessMessage)
+		logReq.Status.PodNames = podNames
+		if err := client.Status().Update(ctx, logReq); err != nil {
+			return "", err
+		}
+	}
+
+	statusString = fmt.Sprintf(
+		"Success. Role %s, RoleBinding %s created for %d pods",
+		role.Name, rb.Name, len(podNames),
+	)
+	return statusString, nil
+}
+
+// getPodNames returns the sorted names of all of the Pods in the namespace
+// that match the supplied selector, regardless of their phase - the logs of a
+// crashing Pod are often the most interesting ones. The list is empty if no
+// Pods match.
+func getPodNames(
+	ctx context.Context,
+	cl client.Client,
+	namespace string,
+	selector labels.Selector,
+) ([]string, error) {
+	podList := &corev1.PodList{}
+	if err := cl.List(ctx, podList,
+		client.InNamespace(namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return nil, err
+	}
+
+	podNames := []string{}
+	for _, pod := range podList.Items {
+		podNames = append(podNames, pod.GetName())
+	}
+	slices.Sort(podNames)
+	return podNames, nil
+}
+
+// podLogsCommand renders a "kubectl logs" command that reads the logs of each
+// of the supplied Pods by name, for requesters that cannot list Pods.
+func podLogsCommand(namespace string, podNames []string) string {
+	if len(podNames) == 1 {
+		return fmt.Sprintf("kubectl logs -n %s %s", namespace, podNames[0])
+	}
+	return fmt.Sprintf(
+		"for pod in %s; do kubectl logs -n %s --prefix pod/$pod; done",
+		strings.Join(podNames, " "), namespace,
+	)
+}
+
+// logsCommand renders the "kubectl logs -l ..." command that the user can run
+// to read the logs of the Pods matching the supplied selector.
+func logsCommand(namespace string, selector labels.Selector) string {
+	sel := selector.String()
+
+	// Set-based selectors (eg. "tier in (a,b)") contain characters that the
+	// shell would otherwise interpret.
+	if strings.ContainsAny(sel, " ()!") {
+		sel = fmt.Sprintf("'%s'", sel)
+	}
+	return fmt.Sprintf("kubectl logs -n %s -l %s --prefix", namespace, sel)
+}
@@ -0,0 +1,310 @@
+package logaccessbuilder
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	bldutil "github.com/diranged/oz/internal/builders/utils"
+	"github.com/diranged/oz/internal/testing/utils"
+)
+
+var _ = Describe("LogAccessBuilder", Ordered, func() {
+	Context("CreateAccessResources()", func() {
+		var (
+			ctx        = context.Background()
+			ns         *corev1.Namespace
+			deployment *appsv1.Deployment
+			pod        *corev1.Pod
+			request    *v1alpha1.LogAccessRequest
+			template   *v1alpha1.LogAccessTemplate
+			builder    = LogAccessBuilder{}
+		)
+
+		BeforeAll(func() {
+			By("Should have a namespace to execute tests in")
+			ns = &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: testutil.RandomString(8),
+				},
+			}
+			err := k8sClient.Create(ctx, ns)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Creating a Deployment to reference for the test")
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(4),
+					Namespace: ns.Name,
+				},
+				Spec: appsv1.DeploymentSpec{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"testLabel": "testValue",
+						},
+					},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								"testLabel": "testValue",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name:  "test",
+									Image: "nginx:latest",
+								},
+							},
+						},
+					},
+				},
+			}
+			err = k8sClient.Create(ctx, deployment)
+			Expect(err).To(Not(HaveOccurred()))
+
+			By("Create a single Pod that should match the Deployment spec above for testing")
+			pod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+					Labels:    deployment.Spec.Selector.MatchLabels,
+				},
+				Spec: deployment.Spec.Template.Spec,
+				Status: corev1.PodStatus{
+					Phase: "Running",
+				},
+			}
+			err = k8sClient.Create(ctx, pod)
+			Expect(err).To(Not(HaveOccurred()))
+
+			By("Should have a LogAccessTemplate to test against")
+			template = &v1alpha1.LogAccessTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.LogAccessTemplateSpec{
+					AccessConfig: v1alpha1.AccessConfig{
+						AllowedGroups:   []string{"foo"},
+						DefaultDuration: "1h",
+						MaxDuration:     "2h",
+					},
+					ControllerTargetRef: &v1alpha1.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       deployment.GetName(),
+					},
+				},
+			}
+			err = k8sClient.Create(ctx, template)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Should have a LogAccessRequest built to test against")
+			request = &v1alpha1.LogAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "createaccessresource-test",
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.LogAccessRequestSpec{
+					TemplateName: template.GetName(),
+				},
+			}
+			err = k8sClient.Create(ctx, request)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterAll(func() {
+			By("Should delete the namespace")
+			err := k8sClient.Delete(ctx, ns)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("CreateAccessResources() should grant access to every matching pod", func() {
+			ret, err := builder.CreateAccessResources(ctx, k8sClient, request, template)
+
+			// VERIFY: No error returned
+			Expect(err).ToNot(HaveOccurred())
+
+			// VERIFY: Proper status string returned
+			Expect(ret).To(MatchRegexp(fmt.Sprintf(
+				"Success. Role %s-.*, RoleBinding %s.* created for 1 pods",
+				request.GetName(),
+				request.GetName(),
+			)))
+
+			// VERIFY: The pod names were recorded, and the access command
+			// reads the pod by name
+			Expect(request.Status.PodNames).To(Equal([]string{pod.GetName()}))
+			Expect(request.Status.AccessMessage).To(Equal(fmt.Sprintf(
+				"kubectl logs -n %s %s",
+				ns.GetName(), pod.GetName(),
+			)))
+
+			// VERIFY: Role Created as expected, with no exec access
+			foundRole := &rbacv1.Role{}
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      bldutil.GenerateResourceName(request),
+				Namespace: ns.GetName(),
+			}, foundRole)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundRole.GetOwnerReferences()).ToNot(BeNil())
+			Expect(foundRole.Rules).To(HaveLen(1))
+			Expect(foundRole.Rules[0].Resources).To(Equal([]string{"pods", "pods/log"}))
+			Expect(foundRole.Rules[0].ResourceNames).To(Equal([]string{pod.GetName()}))
+
+			// VERIFY: RoleBinding Created as expected
+			foundRoleBinding := &rbacv1.RoleBinding{}
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      bldutil.GenerateResourceName(request),
+				Namespace: ns.GetName(),
+			}, foundRoleBinding)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundRoleBinding.RoleRef.Name).To(Equal(foundRole.GetName()))
+			Expect(foundRoleBinding.Subjects[0].Name).To(Equal("foo"))
+		})
+
+		It("CreateAccessResources() should only allow listing pods when the template does", func() {
+			listing := template.DeepCopy()
+			listing.Spec.AllowPodListing = true
+
+			_, err := builder.CreateAccessResources(ctx, k8sClient, request, listing)
+			Expect(err).ToNot(HaveOccurred())
+
+			// VERIFY: The access command uses the label selector
+			Expect(request.Status.AccessMessage).To(Equal(fmt.Sprintf(
+				"kubectl logs -n %s -l testLabel=testValue --prefix",
+				ns.GetName(),
+			)))
+
+			// VERIFY: The Role allows listing every pod in the namespace
+			foundRole := &rbacv1.Role{}
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      bldutil.GenerateResourceName(request),
+				Namespace: ns.GetName(),
+			}, foundRole)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundRole.Rules).To(HaveLen(2))
+			Expect(foundRole.Rules[1].Resources).To(Equal([]string{"pods"}))
+			Expect(foundRole.Rules[1].Verbs).To(Equal([]string{"list"}))
+			Expect(foundRole.Rules[1].ResourceNames).To(BeEmpty())
+
+			By("Removing the list access again with the template default")
+			_, err = builder.CreateAccessResources(ctx, k8sClient, request, template)
+			Expect(err).ToNot(HaveOccurred())
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      bldutil.GenerateResourceName(request),
+				Namespace: ns.GetName(),
+			}, foundRole)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundRole.Rules).To(HaveLen(1))
+		})
+
+		It("CreateAccessResources() should follow new pods", func() {
+			By("Creating a second Pod that matches the Deployment")
+			newPod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+					Labels:    deployment.Spec.Selector.MatchLabels,
+				},
+				Spec: deployment.Spec.Template.Spec,
+				Status: corev1.PodStatus{
+					Phase: "Running",
+				},
+			}
+			err := k8sClient.Create(ctx, newPod)
+			Expect(err).To(Not(HaveOccurred()))
+
+			ret, err := builder.CreateAccessResources(ctx, k8sClient, request, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(MatchRegexp("created for 2 pods"))
+			Expect(request.Status.PodNames).To(ConsistOf(pod.GetName(), newPod.GetName()))
+			Expect(request.Status.AccessMessage).To(Equal(
+				podLogsCommand(ns.GetName(), request.Status.PodNames),
+			))
+			Expect(request.Status.AccessMessage).To(HavePrefix("for pod in "))
+
+			// VERIFY: The existing Role was updated in place
+			foundRole := &rbacv1.Role{}
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      bldutil.GenerateResourceName(request),
+				Namespace: ns.GetName(),
+			}, foundRole)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundRole.Rules[0].ResourceNames).To(ConsistOf(pod.GetName(), newPod.GetName()))
+		})
+
+		It("CreateAccessResources() should revoke access while there are no pods", func() {
+			By("Deleting all of the Pods")
+			err := k8sClient.DeleteAllOf(ctx, &corev1.Pod{},
+				client.InNamespace(ns.GetName()),
+				client.GracePeriodSeconds(0),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			ret, err := builder.CreateAccessResources(ctx, k8sClient, request, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(MatchRegexp("created for 0 pods"))
+			Expect(request.Status.PodNames).To(BeEmpty())
+			Expect(request.Status.AccessMessage).To(ContainSubstring("No Pods match selector"))
+
+			// VERIFY: The Role no longer names the deleted pods
+			foundRole := &rbacv1.Role{}
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      bldutil.GenerateResourceName(request),
+				Namespace: ns.GetName(),
+			}, foundRole)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundRole.Rules).To(BeEmpty())
+
+			// VERIFY: The request waits for the pods to come back
+			ready, err := builder.AccessResourcesAreReady(ctx, k8sClient, request, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ready).To(BeFalse())
+		})
+	})
+
+	Context("podLogsCommand()", func() {
+		It("podLogsCommand() should read a single pod by name", func() {
+			Expect(podLogsCommand("ns", []string{"app-a"})).To(Equal(
+				"kubectl logs -n ns app-a",
+			))
+		})
+
+		It("podLogsCommand() should loop over multiple pods", func() {
+			Expect(podLogsCommand("ns", []string{"app-a", "app-b"})).To(Equal(
+				"for pod in app-a app-b; do kubectl logs -n ns --prefix pod/$pod; done",
+			))
+		})
+	})
+
+	Context("logsCommand()", func() {
+		It("logsCommand() should render an equality selector", func() {
+			selector, err := labels.Parse("app=foo,tier=web")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(logsCommand("ns", selector)).To(Equal(
+				"kubectl logs -n ns -l app=foo,tier=web --prefix",
+			))
+		})
+
+		It("logsCommand() should quote a set-based selector", func() {
+			selector, err := labels.Parse("tier in (api,web)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(logsCommand("ns", selector)).To(Equal(
+				"kubectl logs -n ns -l 'tier in (api,web)' --prefix",
+			))
+		})
+	})
+})
@@ -0,0 +1,24 @@
+// Package logaccessbuilder implements the IBuilder interface for LogAccessRequest resources
+package logaccessbuilder
+
+import (
+	"github.com/diranged/oz/internal/builders"
+)
+
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=logaccessrequests,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=logaccessrequests/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=logaccessrequests/finalizers,verbs=update
+
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete;bind;escalate
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// LogAccessBuilder implements the IBuilder interface for LogAccessRequest resources
+type LogAccessBuilder struct{}
+
+// https://stackoverflow.com/questions/33089523/how-to-mark-golang-struct-as-implementing-interface
+var (
+	_ builders.IBuilder            = &LogAccessBuilder{}
+	_ builders.IBuilder            = (*LogAccessBuilder)(nil)
+	_ builders.IPodWatchingBuilder = &LogAccessBuilder{}
+	_ builders.IPodWatchingBuilder = (*LogAccessBuilder)(nil)
+)
@@ -0,0 +1,6 @@
+package logaccessbuilder
+
+// WatchesPods implements the IPodWatchingBuilder interface. The Role created
+// for a LogAccessRequest lists every Pod of the target controller, so it must
+// be rebuilt as those Pods come and go.
+func (b *LogAccessBuilder) WatchesPods() {}
@@ -4,11 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,11 +15,12 @@ import (
 	"github.com/diranged/oz/internal/api/v1alpha1"
 )
 
-// AccessResourcesAreReady implements the IBuilder interface by checking for
-// the current state of the Pod for the user and returning True when it is
-// ready, or False if it is not ready after a specified timeout.
+// AccessResourcesAreReady implements the IBuilder interface by checking the
+// current state of the Pod for the user, and returning True if it is ready.
 //
-// TODO: Implement a per-pod-access-template setting to tune this timeout.
+// This check never blocks. If the Pod is not ready yet, the RequestReconciler
+// requeues the request - and because the RequestReconciler owns the Pod, any
+// change to the Pod status triggers another reconcile right away.
 func (b *PodAccessBuilder) AccessResourcesAreReady(
 	ctx context.Context,
 	client client.Client,
@@ -47,47 +46,12 @@ func (b *PodAccessBuilder) AccessResourcesAreReady(
 		},
 	}
 
-	log.Info(
-		fmt.Sprintf(
-			"Checking if pod %s is ready yet (timeout: %s)",
-			pod.GetName(),
-			defaultReadyWaitTime,
-		),
-	)
-
-	// Store the ready/err states outside of the loop so that we can return
-	// them at the end of the method.
-	var ready bool
-	var err error
-
-	// In a loop, keep checking the Pod state. When it's ready, return. In an
-	// error, just keep looping. After the timeout has occurrred, we simply
-	// return the last known state.
-	for stay, timeout := true, time.After(defaultReadyWaitTime); stay; {
-		select {
-		case <-timeout:
-			log.Info(fmt.Sprintf("Timeout waiting for %s to become ready.", pod.GetName()))
-			stay = false
-		default:
-			if ready, err = isPodReady(ctx, client, log, pod); err != nil {
-				if apierrors.IsNotFound(err) {
-					// Immediately bail out and let a requeue event happen
-					return false, err
-				}
-				// For any other error, consider it transient and try again
-				log.Error(err, "Error getting Pod status (will retry)")
-			} else if ready {
-				// return ready = true, and no error
-				log.Info("Pod ready state", "phase", pod.Status.Phase)
-				return ready, nil
-			}
-		}
-
-		// Wait 1 second before trying again
-		log.V(1).Info("Sleeping and trying again")
-		time.Sleep(defaultReadyWaitInterval)
+	log.V(1).Info(fmt.Sprintf("Checking if pod %s is ready yet", pod.GetName()))
+	ready, err := isPodReady(ctx, client, log, pod)
+	if err != nil {
+		return false, err
 	}
-
+	log.Info("Pod ready state", "phase", pod.Status.Phase, "ready", ready)
 	return ready, nil
 }
 
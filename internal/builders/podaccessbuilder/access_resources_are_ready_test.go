@@ -24,10 +24,6 @@ var _ = Describe("RequestReconciler", Ordered, func() {
 			builder = PodAccessBuilder{}
 		)
 
-		// Override the retry timeout so it won't take 30s for a failed test
-		defaultReadyWaitTime = 100 * time.Millisecond
-		defaultReadyWaitInterval = 10 * time.Millisecond
-
 		BeforeAll(func() {
 			By("Should have a namespace to execute tests in")
 			ns = &corev1.Namespace{
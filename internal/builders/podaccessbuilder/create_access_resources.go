@@ -5,7 +5,7 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
-	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -28,20 +28,13 @@ func (b *PodAccessBuilder) CreateAccessResources(
 	podTmpl := tmpl.(*v1alpha1.PodAccessTemplate)
 
 	// First, get the desired PodSpec. If there's a failure at this point, return it.
-	podTemplateSpec, err := bldutil.GetPodTemplateFromController(ctx, client, tmpl)
+	podTemplateSpec, granted, err := buildPodTemplateSpec(ctx, client, podReq, podTmpl)
 	if err != nil {
 		log.Error(err, "Failed to generate PodSpec for PodAccessRequest")
 		return "", err
 	}
-
-	// Run the PodSpec through the optional mutation config
-	mutator := podTmpl.Spec.ControllerTargetMutationConfig
-	if mutator != nil {
-		podTemplateSpec, err = mutator.PatchPodTemplateSpec(ctx, podTemplateSpec)
-		if err != nil {
-			log.Error(err, "Failed to mutate PodSpec for PodAccessRequest")
-			return statusString, err
-		}
+	if granted != nil {
+		podReq.Status.Resources = granted
 	}
 
 	// Generate a Pod for the user to access
@@ -51,22 +44,11 @@ func (b *PodAccessBuilder) CreateAccessResources(
 		return statusString, err
 	}
 
-	// Define the permissions the access request will grant.
-	//
-	// TODO: Implement the ability to tune this in the PodAccessTemplate settings.
-	rules := []rbacv1.PolicyRule{
-		{
-			APIGroups:     []string{corev1.GroupName},
-			Resources:     []string{"pods"},
-			ResourceNames: []string{pod.GetName()},
-			Verbs:         []string{"get", "list", "watch"},
-		},
-		{
-			APIGroups:     []string{corev1.GroupName},
-			Resources:     []string{"pods/exec"},
-			ResourceNames: []string{pod.GetName()},
-			Verbs:         []string{"create", "update", "delete", "get", "list"},
-		},
+	// Define the permissions the access request will grant, scoped to the
+	// target pod.
+	rules, err := bldutil.GeneratePolicyRules(podTmpl.GetAccessRules(), pod.GetName())
+	if err != nil {
+		return statusString, err
 	}
 
 	// Get the Role, or error out
@@ -111,3 +93,75 @@ func (b *PodAccessBuilder) CreateAccessResources(
 	)
 	return statusString, nil
 }
+
+// buildPodTemplateSpec returns the PodTemplateSpec for the Pod that is
+// launched for the request, with any resources the user asked for applied to
+// the default container. The granted resources are returned too, if there
+// were any.
+func buildPodTemplateSpec(
+	ctx context.Context,
+	client client.Client,
+	podReq *v1alpha1.PodAccessRequest,
+	podTmpl *v1alpha1.PodAccessTemplate,
+) (corev1.PodTemplateSpec, *corev1.ResourceRequirements, error) {
+	podTemplateSpec, err := getPodTemplateSpec(ctx, client, podReq, podTmpl)
+	if err != nil {
+		return podTemplateSpec, nil, err
+	}
+
+	// Apply any resources the user asked for to the default container. The
+	// webhook has already checked these against the template, but the
+	// template may have changed since.
+	if podReq.Spec.Resources == nil {
+		return podTemplateSpec, nil, nil
+	}
+	if err := podTmpl.VerifyResources(*podReq.Spec.Resources); err != nil {
+		return podTemplateSpec, nil, err
+	}
+	mutator := podTmpl.Spec.ControllerTargetMutationConfig
+	if mutator == nil {
+		mutator = &v1alpha1.PodTemplateSpecMutationConfig{}
+	}
+	podTemplateSpec, granted, err := mutator.ApplyResources(
+		ctx, podTemplateSpec, *podReq.Spec.Resources,
+	)
+	if err != nil {
+		return podTemplateSpec, nil, err
+	}
+	return podTemplateSpec, &granted, nil
+}
+
+// getPodTemplateSpec returns the PodTemplateSpec for the Pod that is launched
+// for the request. A template with a Spec.podSpec uses that directly, labelled
+// with the template and request names. Otherwise the PodTemplateSpec is copied
+// from the Spec.controllerTargetRef, and run through the optional
+// Spec.controllerTargetMutationConfig.
+func getPodTemplateSpec(
+	ctx context.Context,
+	client client.Client,
+	podReq *v1alpha1.PodAccessRequest,
+	podTmpl *v1alpha1.PodAccessTemplate,
+) (corev1.PodTemplateSpec, error) {
+	if podTmpl.Spec.PodSpec != nil {
+		return corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					v1alpha1.LabelTemplateName: podTmpl.GetName(),
+					v1alpha1.LabelRequestName:  podReq.GetName(),
+				},
+			},
+			Spec: *podTmpl.Spec.PodSpec.DeepCopy(),
+		}, nil
+	}
+
+	podTemplateSpec, err := bldutil.GetPodTemplateFromController(ctx, client, podTmpl)
+	if err != nil {
+		return podTemplateSpec, err
+	}
+
+	// Run the PodSpec through the optional mutation config
+	if mutator := podTmpl.Spec.ControllerTargetMutationConfig; mutator != nil {
+		return mutator.PatchPodTemplateSpec(ctx, podTemplateSpec)
+	}
+	return podTemplateSpec, nil
+}
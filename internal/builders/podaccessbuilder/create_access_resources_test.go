@@ -31,6 +31,8 @@ var _ = Describe("RequestReconciler", Ordered, func() {
 			rolloutRequest  *v1alpha1.PodAccessRequest
 			template        *v1alpha1.PodAccessTemplate
 			rolloutTemplate *v1alpha1.PodAccessTemplate
+			podSpecRequest  *v1alpha1.PodAccessRequest
+			podSpecTemplate *v1alpha1.PodAccessTemplate
 			builder         = PodAccessBuilder{}
 		)
 
@@ -190,6 +192,32 @@ var _ = Describe("RequestReconciler", Ordered, func() {
 			err = k8sClient.Create(ctx, rolloutTemplate)
 			Expect(err).ToNot(HaveOccurred())
 
+			podSpecTemplate = &v1alpha1.PodAccessTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.PodAccessTemplateSpec{
+					AccessConfig: v1alpha1.AccessConfig{
+						AllowedGroups:   []string{"testGroupA"},
+						DefaultDuration: "1h",
+						MaxDuration:     "2h",
+					},
+					MaxCPU: resource.MustParse("4"),
+					PodSpec: &corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:    "shell",
+								Image:   "busybox:latest",
+								Command: []string{"/bin/sleep", "infinity"},
+							},
+						},
+					},
+				},
+			}
+			err = k8sClient.Create(ctx, podSpecTemplate)
+			Expect(err).ToNot(HaveOccurred())
+
 			By("Should have an PodAccessRequest built to test against")
 			request = &v1alpha1.PodAccessRequest{
 				ObjectMeta: metav1.ObjectMeta{
@@ -215,6 +243,24 @@ var _ = Describe("RequestReconciler", Ordered, func() {
 			}
 			err = k8sClient.Create(ctx, rolloutRequest)
 			Expect(err).ToNot(HaveOccurred())
+
+			// verify podaccess request with a standalone PodSpec
+			podSpecRequest = &v1alpha1.PodAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "createaccessresource-podspec-test",
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.PodAccessRequestSpec{
+					TemplateName: podSpecTemplate.GetName(),
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("2"),
+						},
+					},
+				},
+			}
+			err = k8sClient.Create(ctx, podSpecRequest)
+			Expect(err).ToNot(HaveOccurred())
 		})
 
 		AfterAll(func() {
@@ -325,5 +371,60 @@ var _ = Describe("RequestReconciler", Ordered, func() {
 			Expect(foundRoleBinding.RoleRef.Name).To(Equal(foundRole.GetName()))
 			Expect(foundRoleBinding.Subjects[0].Name).To(Equal("testGroupA"))
 		})
+
+		It("CreateAccessResources() should succeed with a standalone PodSpec", func() {
+			podSpecRequest.Status.PodName = ""
+
+			// Execute
+			ret, err := builder.CreateAccessResources(ctx, k8sClient, podSpecRequest, podSpecTemplate)
+
+			// VERIFY: No error returned
+			Expect(err).ToNot(HaveOccurred())
+
+			// VERIFY: Proper status string returned
+			Expect(ret).To(MatchRegexp(fmt.Sprintf(
+				"Success. Pod %s-.*, Role %s-.*, RoleBinding %s.* created",
+				podSpecRequest.GetName(),
+				podSpecRequest.GetName(),
+				podSpecRequest.GetName(),
+			)))
+
+			// VERIFY: Pod Created from the PodSpec, and labeled
+			foundPod := &corev1.Pod{}
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      bldutil.GenerateResourceName(podSpecRequest),
+				Namespace: ns.GetName(),
+			}, foundPod)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundPod.GetOwnerReferences()).ToNot(BeNil())
+			Expect(foundPod.Spec.Containers[0].Name).To(Equal("shell"))
+			Expect(foundPod.Spec.Containers[0].Image).To(Equal("busybox:latest"))
+			Expect(foundPod.GetLabels()).To(HaveKeyWithValue(
+				v1alpha1.LabelTemplateName, podSpecTemplate.GetName(),
+			))
+			Expect(foundPod.GetLabels()).To(HaveKeyWithValue(
+				v1alpha1.LabelRequestName, podSpecRequest.GetName(),
+			))
+
+			// VERIFY: The requested resources were applied, and recorded
+			Expect(foundPod.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("2"))
+			Expect(podSpecRequest.Status.Resources).ToNot(BeNil())
+			Expect(podSpecRequest.Status.Resources.Requests.Cpu().String()).To(Equal("2"))
+
+			// VERIFY: The template's PodSpec was not modified
+			Expect(podSpecTemplate.Spec.PodSpec.Containers).To(HaveLen(1))
+
+			// VERIFY: Access message points at the Pod
+			Expect(podSpecRequest.Status.AccessMessage).To(ContainSubstring(foundPod.GetName()))
+
+			// VERIFY: Role Created as expected
+			foundRole := &rbacv1.Role{}
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      bldutil.GenerateResourceName(podSpecRequest),
+				Namespace: ns.GetName(),
+			}, foundRole)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundRole.Rules[0].ResourceNames[0]).To(Equal(foundPod.GetName()))
+		})
 	})
 })
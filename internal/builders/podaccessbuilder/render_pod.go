@@ -0,0 +1,37 @@
+package podaccessbuilder
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	bldutil "github.com/diranged/oz/internal/builders/utils"
+)
+
+// RenderPod returns the Pod that CreateAccessResources would launch for the
+// request, along with the rendered access command - without creating
+// anything. The client is only used to read the controller targeted by the
+// template, which lets template authors preview their templates offline.
+func RenderPod(
+	ctx context.Context,
+	client client.Client,
+	podReq *v1alpha1.PodAccessRequest,
+	podTmpl *v1alpha1.PodAccessTemplate,
+) (*corev1.Pod, string, error) {
+	podTemplateSpec, _, err := buildPodTemplateSpec(ctx, client, podReq, podTmpl)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pod := bldutil.NewPod(podReq, podTemplateSpec)
+	accessString, err := bldutil.CreateAccessCommand(
+		podTmpl.Spec.AccessConfig.AccessCommand,
+		pod.ObjectMeta,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	return pod, accessString, nil
+}
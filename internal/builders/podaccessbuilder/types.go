@@ -2,8 +2,6 @@
 package podaccessbuilder
 
 import (
-	"time"
-
 	"github.com/diranged/oz/internal/builders"
 )
 
@@ -16,13 +14,6 @@ import (
 //+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=argoproj.io,resources=rollouts,verbs=get;list;watch
 
-// defaultReadyWaitTime is the default time in which we wait for resources to
-// become Ready in the AccessResourcesAreReady() method.
-var defaultReadyWaitTime = 30 * time.Second
-
-// defaultReadyWaitInterval is the time inbetween checks on the Pod status.
-var defaultReadyWaitInterval = time.Second
-
 // PodAccessBuilder implements the IBuilder interface for PodAccessRequest resources
 type PodAccessBuilder struct{}
 
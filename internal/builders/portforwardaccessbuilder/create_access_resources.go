@@ -0,0 +1,101 @@
+package portforwardaccessbuilder
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/builders/internal/podselection"
+	bldutil "github.com/diranged/oz/internal/builders/utils"
+)
+
+// accessRules are the permissions granted on the target pod. The "get" on the
+// pod itself is required by "kubectl port-forward", which looks up the pod
+// before opening the stream - but no exec/attach access is granted.
+var accessRules = []v1alpha1.AccessRule{
+	{
+		Resources: []v1alpha1.AccessRuleResource{"pods"},
+		Verbs:     []string{"get"},
+	},
+	{
+		Resources: []v1alpha1.AccessRuleResource{"pods/portforward"},
+		Verbs:     []string{"create", "get"},
+	},
+}
+
+// CreateAccessResources implements the IBuilder interface
+func (b *PortForwardAccessBuilder) CreateAccessResources(
+	ctx context.Context,
+	client client.Client,
+	req v1alpha1.IRequestResource,
+	tmpl v1alpha1.ITemplateResource,
+) (statusString string, err error) {
+	// Cast the Request into a PortForwardAccessRequest.
+	pfReq := req.(*v1alpha1.PortForwardAccessRequest)
+	// Cast the Template into a PortForwardAccessTemplate.
+	pfTmpl := tmpl.(*v1alpha1.PortForwardAccessTemplate)
+
+	// The webhook verifies the ports when the request is created, but the
+	// template may have changed since then.
+	if err := pfReq.VerifyPorts(pfTmpl); err != nil {
+		return statusString, err
+	}
+
+	// Get the target Pod Name that the user is going to have access to
+	targetPod, err := podselection.GetPod(ctx, client, pfReq, pfTmpl, pfReq.Spec.TargetPod)
+	if err != nil {
+		return statusString, err
+	}
+
+	// Define the permissions the access request will grant, scoped to the
+	// target pod.
+	rules, err := bldutil.GeneratePolicyRules(accessRules, targetPod.GetName())
+	if err != nil {
+		return statusString, err
+	}
+
+	// Get the Role, or error out
+	role, err := bldutil.CreateRole(ctx, client, pfReq, rules)
+	if err != nil {
+		return statusString, err
+	}
+
+	// Get the Binding, or error out
+	rb, err := bldutil.CreateRoleBinding(ctx, client, pfReq, tmpl, role)
+	if err != nil {
+		return statusString, err
+	}
+
+	pfReq.Status.SetAccessMessage(portForwardCommand(targetPod.GetNamespace(), targetPod.GetName(), pfReq.GetPorts(pfTmpl)))
+
+	// Record the selected pod so that subsequent reconciles always use the
+	// same one.
+	if err := pfReq.SetPodName(targetPod.GetName()); err != nil {
+		return "", err
+	}
+
+	// We've been mutating the pfReq Status throughout this build. Need to
+	// push the update back to the cluster here.
+	if err := client.Status().Update(ctx, pfReq); err != nil {
+		return "", err
+	}
+
+	statusString = fmt.Sprintf("Success. Role %s, RoleBinding %s created", role.Name, rb.Name)
+	return statusString, nil
+}
+
+// portForwardCommand renders the "kubectl port-forward ..." command that the
+// user can run to forward each of the supplied ports to the same local port.
+func portForwardCommand(namespace string, podName string, ports []int32) string {
+	mappings := []string{}
+	for _, port := range ports {
+		mappings = append(mappings, fmt.Sprintf("%d:%d", port, port))
+	}
+	return fmt.Sprintf(
+		"kubectl port-forward -n %s %s %s",
+		namespace, podName, strings.Join(mappings, " "),
+	)
+}
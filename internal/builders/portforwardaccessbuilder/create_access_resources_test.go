@@ -0,0 +1,213 @@
+package portforwardaccessbuilder
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/builders/internal/podselection"
+	bldutil "github.com/diranged/oz/internal/builders/utils"
+	"github.com/diranged/oz/internal/testing/utils"
+)
+
+var _ = Describe("PortForwardAccessBuilder", Ordered, func() {
+	Context("CreateAccessResources()", func() {
+		var (
+			ctx        = context.Background()
+			ns         *corev1.Namespace
+			deployment *appsv1.Deployment
+			pod        *corev1.Pod
+			request    *v1alpha1.PortForwardAccessRequest
+			template   *v1alpha1.PortForwardAccessTemplate
+			builder    = PortForwardAccessBuilder{}
+		)
+
+		// For Envtest
+		podselection.PodPhaseRunning = "Pending"
+
+		BeforeAll(func() {
+			By("Should have a namespace to execute tests in")
+			ns = &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: testutil.RandomString(8),
+				},
+			}
+			err := k8sClient.Create(ctx, ns)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Creating a Deployment to reference for the test")
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(4),
+					Namespace: ns.Name,
+				},
+				Spec: appsv1.DeploymentSpec{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"testLabel": "testValue",
+						},
+					},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								"testLabel": "testValue",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name:  "test",
+									Image: "nginx:latest",
+								},
+							},
+						},
+					},
+				},
+			}
+			err = k8sClient.Create(ctx, deployment)
+			Expect(err).To(Not(HaveOccurred()))
+
+			By("Create a single Pod that should match the Deployment spec above for testing")
+			pod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+					Labels:    deployment.Spec.Selector.MatchLabels,
+				},
+				Spec: deployment.Spec.Template.Spec,
+				Status: corev1.PodStatus{
+					Phase: "Running",
+				},
+			}
+			err = k8sClient.Create(ctx, pod)
+			Expect(err).To(Not(HaveOccurred()))
+
+			By("Should have a PortForwardAccessTemplate to test against")
+			template = &v1alpha1.PortForwardAccessTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.PortForwardAccessTemplateSpec{
+					AccessConfig: v1alpha1.AccessConfig{
+						AllowedGroups:   []string{"foo"},
+						DefaultDuration: "1h",
+						MaxDuration:     "2h",
+					},
+					ControllerTargetRef: &v1alpha1.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       deployment.GetName(),
+					},
+					AllowedPorts: []int32{5432, 8080},
+				},
+			}
+			err = k8sClient.Create(ctx, template)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Should have a PortForwardAccessRequest built to test against")
+			request = &v1alpha1.PortForwardAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "createaccessresource-test",
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.PortForwardAccessRequestSpec{
+					TemplateName: template.GetName(),
+				},
+			}
+			err = k8sClient.Create(ctx, request)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterAll(func() {
+			By("Should delete the namespace")
+			err := k8sClient.Delete(ctx, ns)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("CreateAccessResources() should fail if a port is not allowed", func() {
+			request.Spec.Ports = []int32{22}
+			_, err := builder.CreateAccessResources(ctx, k8sClient, request, template)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(MatchRegexp("port 22 is not one of the allowedPorts"))
+		})
+
+		It("CreateAccessResources() should fail if pod is missing", func() {
+			request.Spec.Ports = nil
+			request.Spec.TargetPod = "testPod"
+			_, err := builder.CreateAccessResources(ctx, k8sClient, request, template)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(MatchRegexp("not found"))
+		})
+
+		It("CreateAccessResources() should succeed with random pod selection", func() {
+			request.Spec.TargetPod = ""
+			request.Spec.Ports = []int32{5432}
+
+			ret, err := builder.CreateAccessResources(ctx, k8sClient, request, template)
+
+			// VERIFY: No error returned
+			Expect(err).ToNot(HaveOccurred())
+
+			// VERIFY: Proper status string returned
+			Expect(ret).To(MatchRegexp(fmt.Sprintf(
+				"Success. Role %s-.*, RoleBinding %s.* created",
+				request.GetName(),
+				request.GetName(),
+			)))
+
+			// VERIFY: The pod name was recorded, and the access command
+			// only forwards the requested port
+			Expect(request.GetPodName()).To(Equal(pod.GetName()))
+			Expect(request.Status.AccessMessage).To(Equal(fmt.Sprintf(
+				"kubectl port-forward -n %s %s 5432:5432",
+				ns.GetName(),
+				pod.GetName(),
+			)))
+
+			// VERIFY: Role Created as expected, with no exec access
+			foundRole := &rbacv1.Role{}
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      bldutil.GenerateResourceName(request),
+				Namespace: ns.GetName(),
+			}, foundRole)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundRole.GetOwnerReferences()).ToNot(BeNil())
+			Expect(foundRole.Rules).To(HaveLen(2))
+			Expect(foundRole.Rules[0].Resources).To(Equal([]string{"pods"}))
+			Expect(foundRole.Rules[0].Verbs).To(Equal([]string{"get"}))
+			Expect(foundRole.Rules[1].Resources).To(Equal([]string{"pods/portforward"}))
+			Expect(foundRole.Rules[1].ResourceNames[0]).To(Equal(pod.GetName()))
+
+			// VERIFY: RoleBinding Created as expected
+			foundRoleBinding := &rbacv1.RoleBinding{}
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      bldutil.GenerateResourceName(request),
+				Namespace: ns.GetName(),
+			}, foundRoleBinding)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundRoleBinding.RoleRef.Name).To(Equal(foundRole.GetName()))
+			Expect(foundRoleBinding.Subjects[0].Name).To(Equal("foo"))
+		})
+	})
+
+	Context("portForwardCommand()", func() {
+		It("portForwardCommand() should forward each port", func() {
+			Expect(portForwardCommand("ns", "pod", []int32{5432})).To(Equal(
+				"kubectl port-forward -n ns pod 5432:5432",
+			))
+			Expect(portForwardCommand("ns", "pod", []int32{5432, 8080})).To(Equal(
+				"kubectl port-forward -n ns pod 5432:5432 8080:8080",
+			))
+		})
+	})
+})
@@ -0,0 +1,22 @@
+// Package portforwardaccessbuilder implements the IBuilder interface for PortForwardAccessRequest resources
+package portforwardaccessbuilder
+
+import (
+	"github.com/diranged/oz/internal/builders"
+)
+
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=portforwardaccessrequests,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=portforwardaccessrequests/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=portforwardaccessrequests/finalizers,verbs=update
+
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete;bind;escalate
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// PortForwardAccessBuilder implements the IBuilder interface for PortForwardAccessRequest resources
+type PortForwardAccessBuilder struct{}
+
+// https://stackoverflow.com/questions/33089523/how-to-mark-golang-struct-as-implementing-interface
+var (
+	_ builders.IBuilder = &PortForwardAccessBuilder{}
+	_ builders.IBuilder = (*PortForwardAccessBuilder)(nil)
+)
@@ -25,35 +25,26 @@ func CreatePod(
 ) (*corev1.Pod, error) {
 	logger := logf.FromContext(ctx)
 
-	// We'll populate this pod object
-	pod := &corev1.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      GenerateResourceName(req),
-			Namespace: req.GetNamespace(),
-		},
-	}
-
 	// Verify first whether or not a pod already exists with this name. If it
 	// does, we just return it back. The issue here is that updating a Pod is
 	// an unusual thing to do once it's alive, and can cause race condition
 	// issues if you do not do the updates properly.
 	//
 	// https://github.com/diranged/oz/issues/27
+	found := &corev1.Pod{}
 	err := client.Get(ctx, types.NamespacedName{
-		Name:      pod.Name,
-		Namespace: pod.Namespace,
-	}, pod)
+		Name:      GenerateResourceName(req),
+		Namespace: req.GetNamespace(),
+	}, found)
 
 	// If there was no error on this get, then the object already exists in K8S
 	// and we need to just return that.
 	if err == nil {
-		return pod, err
+		return found, err
 	}
 
-	// Finish filling out the desired PodSpec at this point.
-	pod.Spec = *podTemplateSpec.Spec.DeepCopy()
-	pod.Annotations = podTemplateSpec.Annotations
-	pod.Labels = podTemplateSpec.Labels
+	// Fill out the desired Pod at this point.
+	pod := NewPod(req, podTemplateSpec)
 
 	// Set the ownerRef for the Deployment
 	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/owners-dependents/
@@ -72,3 +63,17 @@ func CreatePod(
 
 	return pod, nil
 }
+
+// NewPod returns the Pod that CreatePod creates for the request from the
+// supplied PodTemplateSpec, without its OwnerReference.
+func NewPod(req client.Object, podTemplateSpec corev1.PodTemplateSpec) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        GenerateResourceName(req),
+			Namespace:   req.GetNamespace(),
+			Annotations: podTemplateSpec.Annotations,
+			Labels:      podTemplateSpec.Labels,
+		},
+		Spec: *podTemplateSpec.Spec.DeepCopy(),
+	}
+}
@@ -2,6 +2,7 @@ package bldutil
 
 import (
 	"context"
+	"fmt"
 
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,7 +13,8 @@ import (
 )
 
 // CreateRoleBinding will create a RoleBinding to a Role for a set of Groups
-// defined in an Access Template.
+// defined in an Access Template, or for the requesting user alone if the
+// template's AccessConfig.bindTo is set to "requester".
 func CreateRoleBinding(
 	ctx context.Context,
 	client client.Client,
@@ -33,12 +35,30 @@ func CreateRoleBinding(
 		Subjects: []rbacv1.Subject{},
 	}
 
-	for _, group := range tmpl.GetAccessConfig().GetAllowedGroups() {
+	switch tmpl.GetAccessConfig().GetBindTo() {
+	case v1alpha1.BindToRequester:
+		// Bind only the user that created the request, so that other members
+		// of the AllowedGroups cannot make use of this access.
+		requester := req.GetRequestedBy()
+		if requester == nil || requester.Username == "" {
+			return nil, fmt.Errorf(
+				"template %s requires binding to the requester, but %s has no spec.requestedBy",
+				tmpl.GetName(), req.GetName(),
+			)
+		}
 		rb.Subjects = append(rb.Subjects, rbacv1.Subject{
 			APIGroup: rbacv1.SchemeGroupVersion.Group,
-			Kind:     rbacv1.GroupKind,
-			Name:     group,
+			Kind:     rbacv1.UserKind,
+			Name:     requester.Username,
 		})
+	default:
+		for _, group := range tmpl.GetAccessConfig().GetAllowedGroups() {
+			rb.Subjects = append(rb.Subjects, rbacv1.Subject{
+				APIGroup: rbacv1.SchemeGroupVersion.Group,
+				Kind:     rbacv1.GroupKind,
+				Name:     group,
+			})
+		}
 	}
 
 	// Set the ownerRef for the Deployment
@@ -0,0 +1,68 @@
+package bldutil
+
+import (
+	"errors"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+// GeneratePolicyRules converts a set of AccessRules from an Access Template
+// into RBAC PolicyRules that are scoped to the supplied target Pod name. Each
+// rule is validated first, so that a template can never grant access to
+// anything other than the target Pod.
+//
+// Returns:
+//
+//   - []rbacv1.PolicyRule: The rules to put into the Role for the request
+//   - error: If any rule is invalid, or no pod name was supplied
+func GeneratePolicyRules(
+	rules []v1alpha1.AccessRule,
+	podName string,
+) ([]rbacv1.PolicyRule, error) {
+	if podName == "" {
+		return nil, errors.New("cannot generate access rules without a target pod name")
+	}
+	return GeneratePolicyRulesForPods(rules, []string{podName})
+}
+
+// GeneratePolicyRulesForPods behaves like GeneratePolicyRules, but scopes the
+// rules to a set of Pod names rather than a single target Pod.
+//
+// Returns:
+//
+//   - []rbacv1.PolicyRule: The rules to put into the Role for the request
+//   - error: If any rule is invalid, or no pod names were supplied
+func GeneratePolicyRulesForPods(
+	rules []v1alpha1.AccessRule,
+	podNames []string,
+) ([]rbacv1.PolicyRule, error) {
+	// An empty ResourceNames list would grant access to every Pod in the
+	// namespace, so this must never be allowed through.
+	if len(podNames) == 0 || slices.Contains(podNames, "") {
+		return nil, errors.New("cannot generate access rules without target pod names")
+	}
+
+	policyRules := []rbacv1.PolicyRule{}
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+
+		resources := []string{}
+		for _, resource := range rule.Resources {
+			resources = append(resources, string(resource))
+		}
+
+		policyRules = append(policyRules, rbacv1.PolicyRule{
+			APIGroups:     []string{corev1.GroupName},
+			Resources:     resources,
+			ResourceNames: podNames,
+			Verbs:         rule.Verbs,
+		})
+	}
+	return policyRules, nil
+}
@@ -0,0 +1,140 @@
+package bldutil
+
+import (
+	"reflect"
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+func TestGeneratePolicyRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []v1alpha1.AccessRule
+		podName string
+		want    []rbacv1.PolicyRule
+		wantErr bool
+	}{
+		{
+			name:    "default rules",
+			rules:   v1alpha1.DefaultAccessRules(),
+			podName: "pod-a",
+			want: []rbacv1.PolicyRule{
+				{
+					APIGroups:     []string{""},
+					Resources:     []string{"pods"},
+					ResourceNames: []string{"pod-a"},
+					Verbs:         []string{"get", "list", "watch"},
+				},
+				{
+					APIGroups:     []string{""},
+					Resources:     []string{"pods/exec"},
+					ResourceNames: []string{"pod-a"},
+					Verbs:         []string{"create", "update", "delete", "get", "list"},
+				},
+			},
+		},
+		{
+			name: "read-only rules",
+			rules: []v1alpha1.AccessRule{
+				{
+					Resources: []v1alpha1.AccessRuleResource{"pods", "pods/log"},
+					Verbs:     []string{"get"},
+				},
+			},
+			podName: "pod-a",
+			want: []rbacv1.PolicyRule{
+				{
+					APIGroups:     []string{""},
+					Resources:     []string{"pods", "pods/log"},
+					ResourceNames: []string{"pod-a"},
+					Verbs:         []string{"get"},
+				},
+			},
+		},
+		{
+			name: "non-pod resource",
+			rules: []v1alpha1.AccessRule{
+				{Resources: []v1alpha1.AccessRuleResource{"secrets"}, Verbs: []string{"get"}},
+			},
+			podName: "pod-a",
+			wantErr: true,
+		},
+		{
+			name: "wildcard verb",
+			rules: []v1alpha1.AccessRule{
+				{Resources: []v1alpha1.AccessRuleResource{"pods"}, Verbs: []string{"*"}},
+			},
+			podName: "pod-a",
+			wantErr: true,
+		},
+		{
+			name:    "missing pod name",
+			rules:   v1alpha1.DefaultAccessRules(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GeneratePolicyRules(tt.rules, tt.podName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GeneratePolicyRules() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GeneratePolicyRules() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeneratePolicyRulesForPods(t *testing.T) {
+	rules := []v1alpha1.AccessRule{
+		{
+			Resources: []v1alpha1.AccessRuleResource{"pods/log"},
+			Verbs:     []string{"get"},
+		},
+	}
+	tests := []struct {
+		name     string
+		podNames []string
+		want     []rbacv1.PolicyRule
+		wantErr  bool
+	}{
+		{
+			name:     "multiple pods",
+			podNames: []string{"pod-a", "pod-b"},
+			want: []rbacv1.PolicyRule{
+				{
+					APIGroups:     []string{""},
+					Resources:     []string{"pods/log"},
+					ResourceNames: []string{"pod-a", "pod-b"},
+					Verbs:         []string{"get"},
+				},
+			},
+		},
+		{
+			name:    "no pods",
+			wantErr: true,
+		},
+		{
+			name:     "empty pod name",
+			podNames: []string{"pod-a", ""},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GeneratePolicyRulesForPods(rules, tt.podNames)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GeneratePolicyRulesForPods() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GeneratePolicyRulesForPods() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -13,5 +13,5 @@ import (
 //
 //	string: A resource name string
 func GenerateResourceName(req client.Object) string {
-	return fmt.Sprintf("%s-%s", req.GetName(), getShortUID(req))
+	return fmt.Sprintf("%s-%s", req.GetName(), GetShortUID(req))
 }
@@ -4,14 +4,20 @@ import "sigs.k8s.io/controller-runtime/pkg/client"
 
 const shortUIDLength = 8
 
-// getShortUID returns back a shortened version of the UID that the Kubernetes cluster used to store
+// GetShortUID returns back a shortened version of the UID that the Kubernetes cluster used to store
 // the AccessRequest internally. This is used by the Builders to create unique names for the
 // resources they manage (Roles, RoleBindings, etc).
 //
+// Objects that have not been stored yet have no UID (or, in tests, a short
+// one) - in that case the UID is returned as-is, rather than panicking.
+//
 // Returns:
 //
-//	shortUID: A 10-digit long shortened UID
-func getShortUID(obj client.Object) string {
-	// TODO: If the UID isn't there, we should generate something random OR throw an error.
-	return string(obj.GetUID())[0:shortUIDLength]
+//	shortUID: An (up to) 8-digit long shortened UID
+func GetShortUID(obj client.Object) string {
+	uid := string(obj.GetUID())
+	if len(uid) < shortUIDLength {
+		return uid
+	}
+	return uid[0:shortUIDLength]
 }
@@ -2,6 +2,7 @@ package bldutil
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/diranged/oz/internal/api/v1alpha1"
 	"k8s.io/apimachinery/pkg/types"
@@ -21,6 +22,10 @@ func GetTargetRefResource(
 	client client.Client,
 	tmpl v1alpha1.ITemplateResource,
 ) (client.Object, error) {
+	if tmpl.GetTargetRef() == nil {
+		return nil, fmt.Errorf("template %s has no controllerTargetRef", tmpl.GetName())
+	}
+
 	// https://blog.gripdev.xyz/2020/07/20/k8s-operator-with-dynamic-crds-using-controller-runtime-no-structs/
 	obj := tmpl.GetTargetRef().GetObject()
 	err := client.Get(ctx, types.NamespacedName{
@@ -8,6 +8,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/diranged/oz/internal/api/v1alpha1"
@@ -126,14 +127,52 @@ var _ = Describe("IBuilder / Utils", Ordered, func() {
 			Expect(err).To(Not(HaveOccurred()))
 		})
 
-		It("getShortUID should work", func() {
-			ret := getShortUID(request)
+		It("GetShortUID should work", func() {
+			ret := GetShortUID(request)
 			Expect(len(ret)).To(Equal(8))
 		})
 
+		It("GetShortUID should not panic on short or missing UIDs", func() {
+			Expect(GetShortUID(&corev1.Pod{})).To(Equal(""))
+			Expect(GetShortUID(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{UID: "abc"}})).To(Equal("abc"))
+		})
+
 		It("generateResourceName should work", func() {
 			ret := GenerateResourceName(request)
 			Expect(len(ret)).To(Equal(17))
 		})
+
+		It("CreateRoleBinding should bind the AllowedGroups by default", func() {
+			role := &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "test-role"}}
+			rb, err := CreateRoleBinding(ctx, k8sClient, request, template, role)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(rb.Subjects).To(Equal([]rbacv1.Subject{{
+				APIGroup: rbacv1.GroupName,
+				Kind:     rbacv1.GroupKind,
+				Name:     "testGroupA",
+			}}))
+		})
+
+		It("CreateRoleBinding should bind only the requester when bindTo=requester", func() {
+			role := &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "test-role"}}
+			template.Spec.AccessConfig.BindTo = api.BindToRequester
+
+			By("Failing if the requester is unknown")
+			_, err := CreateRoleBinding(ctx, k8sClient, request, template, role)
+			Expect(err).To(HaveOccurred())
+
+			By("Binding the requesting User")
+			request.Spec.RequestedBy = &api.RequesterInfo{
+				Username: "alice",
+				Groups:   []string{"testGroupA"},
+			}
+			rb, err := CreateRoleBinding(ctx, k8sClient, request, template, role)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(rb.Subjects).To(Equal([]rbacv1.Subject{{
+				APIGroup: rbacv1.GroupName,
+				Kind:     rbacv1.UserKind,
+				Name:     "alice",
+			}}))
+		})
 	})
 })
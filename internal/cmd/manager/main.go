@@ -34,8 +34,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/builders/ephemeralcontaineraccessbuilder"
 	"github.com/diranged/oz/internal/builders/execaccessbuilder"
+	"github.com/diranged/oz/internal/builders/logaccessbuilder"
 	"github.com/diranged/oz/internal/builders/podaccessbuilder"
+	"github.com/diranged/oz/internal/builders/portforwardaccessbuilder"
 	"github.com/diranged/oz/internal/controllers/podwatcher"
 	"github.com/diranged/oz/internal/controllers/requestcontroller"
 	"github.com/diranged/oz/internal/controllers/templatecontroller"
@@ -164,6 +167,30 @@ func Main() {
 		setupLog.Error(err, "unable to create webhook", "webhook", "ExecAccessRequest")
 		os.Exit(1)
 	}
+	if err = (&v1alpha1.PortForwardAccessRequest{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "PortForwardAccessRequest")
+		os.Exit(1)
+	}
+	if err = (&v1alpha1.LogAccessRequest{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "LogAccessRequest")
+		os.Exit(1)
+	}
+	if err = (&v1alpha1.EphemeralContainerAccessRequest{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "EphemeralContainerAccessRequest")
+		os.Exit(1)
+	}
+	if err = (&v1alpha1.ExecAccessTemplate{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ExecAccessTemplate")
+		os.Exit(1)
+	}
+	if err = (&v1alpha1.PodAccessTemplate{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "PodAccessTemplate")
+		os.Exit(1)
+	}
+	if err = (&v1alpha1.AccessApproval{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "AccessApproval")
+		os.Exit(1)
+	}
 
 	// These special Webhooks are registered for the purpose of event-logging
 	// user-actions.
@@ -198,7 +225,8 @@ func Main() {
 	// registered above.
 	//
 	if err = templatecontroller.NewTemplateReconciler(
-		mgr, &v1alpha1.ExecAccessTemplate{}, templateReconciliationInterval,
+		mgr, &v1alpha1.ExecAccessTemplate{}, &v1alpha1.ExecAccessRequest{},
+		templateReconciliationInterval,
 	).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, unableToCreateMsg, controllerKey, "ExecAccessTemplate")
 		os.Exit(1)
@@ -217,7 +245,8 @@ func Main() {
 	}
 
 	if err = templatecontroller.NewTemplateReconciler(
-		mgr, &v1alpha1.PodAccessTemplate{}, templateReconciliationInterval,
+		mgr, &v1alpha1.PodAccessTemplate{}, &v1alpha1.PodAccessRequest{},
+		templateReconciliationInterval,
 	).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, unableToCreateMsg, controllerKey, "PodAccessTemplate")
 		os.Exit(1)
@@ -235,6 +264,66 @@ func Main() {
 		os.Exit(1)
 	}
 
+	if err = templatecontroller.NewTemplateReconciler(
+		mgr, &v1alpha1.PortForwardAccessTemplate{}, &v1alpha1.PortForwardAccessRequest{},
+		templateReconciliationInterval,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, unableToCreateMsg, controllerKey, "PortForwardAccessTemplate")
+		os.Exit(1)
+	}
+
+	if err = (&requestcontroller.RequestReconciler{
+		Client:                 mgr.GetClient(),
+		Scheme:                 mgr.GetScheme(),
+		APIReader:              mgr.GetAPIReader(),
+		RequestType:            &v1alpha1.PortForwardAccessRequest{},
+		Builder:                &portforwardaccessbuilder.PortForwardAccessBuilder{},
+		ReconciliationInterval: time.Duration(requestReconciliationInterval) * time.Minute,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, unableToCreateMsg, controllerKey, "PortForwardAccessRequest")
+		os.Exit(1)
+	}
+
+	if err = templatecontroller.NewTemplateReconciler(
+		mgr, &v1alpha1.LogAccessTemplate{}, &v1alpha1.LogAccessRequest{},
+		templateReconciliationInterval,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, unableToCreateMsg, controllerKey, "LogAccessTemplate")
+		os.Exit(1)
+	}
+
+	if err = (&requestcontroller.RequestReconciler{
+		Client:                 mgr.GetClient(),
+		Scheme:                 mgr.GetScheme(),
+		APIReader:              mgr.GetAPIReader(),
+		RequestType:            &v1alpha1.LogAccessRequest{},
+		Builder:                &logaccessbuilder.LogAccessBuilder{},
+		ReconciliationInterval: time.Duration(requestReconciliationInterval) * time.Minute,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, unableToCreateMsg, controllerKey, "LogAccessRequest")
+		os.Exit(1)
+	}
+
+	if err = templatecontroller.NewTemplateReconciler(
+		mgr, &v1alpha1.EphemeralContainerAccessTemplate{}, &v1alpha1.EphemeralContainerAccessRequest{},
+		templateReconciliationInterval,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, unableToCreateMsg, controllerKey, "EphemeralContainerAccessTemplate")
+		os.Exit(1)
+	}
+
+	if err = (&requestcontroller.RequestReconciler{
+		Client:                 mgr.GetClient(),
+		Scheme:                 mgr.GetScheme(),
+		APIReader:              mgr.GetAPIReader(),
+		RequestType:            &v1alpha1.EphemeralContainerAccessRequest{},
+		Builder:                &ephemeralcontaineraccessbuilder.EphemeralContainerAccessBuilder{},
+		ReconciliationInterval: time.Duration(requestReconciliationInterval) * time.Minute,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, unableToCreateMsg, controllerKey, "EphemeralContainerAccessRequest")
+		os.Exit(1)
+	}
+
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+var (
+	// Holder for the value of the --reason flag
+	approvalReason string
+
+	// Holder for the value of the --kind flag
+	approvalRequestKind string
+)
+
+// requestKinds maps the Kind of each Access Request to an empty object of
+// that Kind.
+var requestKinds = map[string]func() client.Object{
+	"EphemeralContainerAccessRequest": func() client.Object { return &api.EphemeralContainerAccessRequest{} },
+	"ExecAccessRequest":               func() client.Object { return &api.ExecAccessRequest{} },
+	"LogAccessRequest":                func() client.Object { return &api.LogAccessRequest{} },
+	"PodAccessRequest":                func() client.Object { return &api.PodAccessRequest{} },
+	"PortForwardAccessRequest":        func() client.Object { return &api.PortForwardAccessRequest{} },
+}
+
+var approveExample = `
+Approve an Access Request in the current namespace:
+$ ozctl approve user-abc12
+
+If both an ExecAccessRequest and a PodAccessRequest share the same name, pick one:
+$ ozctl approve user-abc12 --kind PodAccessRequest --reason "ticket #123"
+`
+
+var approveCmd = &cobra.Command{
+	Use:     "approve <Access Request Name>",
+	Short:   "Approve an Access Request that requires approval",
+	Example: approveExample,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		createAccessApproval(cmd, args[0], api.ApprovalDecisionApproved)
+	},
+}
+
+// findAccessRequestKind returns the Kind of the Access Request with the
+// supplied name. If the kind is supplied, only that Kind is searched.
+func findAccessRequestKind(
+	ctx context.Context,
+	cl client.Client,
+	namespace string,
+	name string,
+	kind string,
+) (string, error) {
+	found := []string{}
+	for k, newObj := range requestKinds {
+		if kind != "" && !strings.EqualFold(kind, k) {
+			continue
+		}
+		err := cl.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, newObj())
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		found = append(found, k)
+	}
+
+	slices.Sort(found)
+	switch len(found) {
+	case 0:
+		return "", fmt.Errorf("no Access Request named %q found in namespace %q", name, namespace)
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf(
+			"multiple Access Requests named %q found (%s), please supply --kind",
+			name, strings.Join(found, ", "),
+		)
+	}
+}
+
+func createAccessApproval(cmd *cobra.Command, requestName string, decision api.ApprovalDecision) {
+	cl, namespace := getKubeClient()
+
+	kind, err := findAccessRequestKind(cmd.Context(), cl, namespace, requestName, approvalRequestKind)
+	if err != nil {
+		fmt.Printf(logError("Error - %s\n"), err)
+		os.Exit(1)
+	}
+
+	approval := &api.AccessApproval{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "AccessApproval",
+			APIVersion: api.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-%s-", requestName, strings.ToLower(string(decision))),
+			Namespace:    namespace,
+		},
+		Spec: api.AccessApprovalSpec{
+			RequestName: requestName,
+			RequestKind: kind,
+			Decision:    decision,
+			Reason:      approvalReason,
+		},
+	}
+
+	if err := cl.Create(cmd.Context(), approval); err != nil {
+		fmt.Printf(logError("Error - Creating AccessApproval failed:\n  %s\n"), err)
+		os.Exit(1)
+	}
+
+	cmd.Printf(
+		logSuccess("%s %s %s (%s)\n"),
+		kind, requestName, strings.ToLower(string(decision)), approval.GetName(),
+	)
+}
+
+func init() {
+	approveCmd.Flags().
+		StringVarP(&approvalReason, "reason", "r", "", "Optional reason for the decision")
+	approveCmd.Flags().
+		StringVarP(&approvalRequestKind, "kind", "k", "", "Kind of the Access Request (eg. ExecAccessRequest), only required if the name is ambiguous")
+	kubeConfigFlags.AddFlags(approveCmd.Flags())
+	rootCmd.AddCommand(approveCmd)
+}
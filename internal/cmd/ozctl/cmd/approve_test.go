@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+func TestFindAccessRequestKind(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := api.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	objs := []client.Object{
+		&api.ExecAccessRequest{ObjectMeta: metav1.ObjectMeta{Name: "exec-only", Namespace: "ns"}},
+		&api.PodAccessRequest{ObjectMeta: metav1.ObjectMeta{Name: "pod-only", Namespace: "ns"}},
+		&api.ExecAccessRequest{ObjectMeta: metav1.ObjectMeta{Name: "both", Namespace: "ns"}},
+		&api.PodAccessRequest{ObjectMeta: metav1.ObjectMeta{Name: "both", Namespace: "ns"}},
+	}
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+
+	tests := []struct {
+		name        string
+		requestName string
+		kind        string
+		want        string
+		wantErr     string
+	}{
+		{name: "exec request", requestName: "exec-only", want: "ExecAccessRequest"},
+		{name: "pod request", requestName: "pod-only", want: "PodAccessRequest"},
+		{name: "missing request", requestName: "missing", wantErr: "no Access Request named"},
+		{name: "ambiguous request", requestName: "both", wantErr: "please supply --kind"},
+		{
+			name:        "ambiguous request with kind",
+			requestName: "both",
+			kind:        "podaccessrequest",
+			want:        "PodAccessRequest",
+		},
+		{
+			name:        "wrong kind",
+			requestName: "exec-only",
+			kind:        "PodAccessRequest",
+			wantErr:     "no Access Request named",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findAccessRequestKind(context.Background(), cl, "ns", tt.requestName, tt.kind)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
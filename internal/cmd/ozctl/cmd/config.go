@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+// templateKindAnnotation is set on the commands that take the name of an
+// Access Template as their first argument, to the kind of that template. Those
+// commands also accept the name of an alias from the config file.
+const templateKindAnnotation = "ozctl/template-kind"
+
+// Environment variables that configure ozctl. See rootCmd.Long.
+const (
+	envConfig      = "OZ_CONFIG"
+	envNamespace   = "OZ_NAMESPACE"
+	envDuration    = "OZ_DURATION"
+	envWait        = "OZ_WAIT"
+	envRequestName = "OZ_REQUEST_NAME"
+	envOutput      = "OZ_OUTPUT"
+)
+
+// configDefaults holds the settings that can be defaulted by the config file,
+// the environment or an alias. Each one provides the default for the flag of
+// the same name, on the commands that have it.
+type configDefaults struct {
+	Namespace   string `json:"namespace,omitempty"`
+	Duration    string `json:"duration,omitempty"`
+	Wait        string `json:"wait,omitempty"`
+	RequestName string `json:"requestName,omitempty"`
+	Output      string `json:"output,omitempty"`
+}
+
+// configAlias is a name for a commonly used template - and optionally the
+// namespace and settings to use it with.
+type configAlias struct {
+	configDefaults `json:",inline"`
+
+	// Kind optionally restricts the alias to one kind of template, eg.
+	// PodAccessTemplate.
+	Kind string `json:"kind,omitempty"`
+
+	// Template is the name of the Access Template the alias refers to.
+	Template string `json:"template"`
+}
+
+// ozctlConfig is the content of the ozctl config file.
+type ozctlConfig struct {
+	configDefaults `json:",inline"`
+
+	Aliases map[string]configAlias `json:"aliases,omitempty"`
+}
+
+// config is populated from the config file(s) by initConfig.
+var config = ozctlConfig{}
+
+// configPaths returns the config files to read. $OZ_CONFIG can hold a list of
+// files, separated like $PATH, so that shared team files can be combined with
+// a personal one.
+func configPaths() []string {
+	if env := os.Getenv(envConfig); env != "" {
+		return filepath.SplitList(env)
+	}
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return []string{filepath.Join(dir, "oz", "config.yaml")}
+}
+
+// loadConfig reads and merges the config files. Like $KUBECONFIG, the first
+// file to set a value (or define an alias) wins. Files that do not exist are
+// skipped.
+func loadConfig(paths []string) (ozctlConfig, error) {
+	merged := ozctlConfig{Aliases: map[string]configAlias{}}
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return ozctlConfig{}, err
+		}
+
+		cfg := ozctlConfig{}
+		if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+			return ozctlConfig{}, fmt.Errorf("invalid config file %s: %w", path, err)
+		}
+		for name, alias := range cfg.Aliases {
+			if alias.Template == "" {
+				return ozctlConfig{}, fmt.Errorf("invalid config file %s: alias %q has no template", path, name)
+			}
+			if _, ok := merged.Aliases[name]; !ok {
+				merged.Aliases[name] = alias
+			}
+		}
+		merged.configDefaults = merged.merge(cfg.configDefaults)
+	}
+	return merged, nil
+}
+
+// merge returns d, with any empty settings filled in from other.
+func (d configDefaults) merge(other configDefaults) configDefaults {
+	for _, s := range []struct{ dst, src *string }{
+		{&d.Namespace, &other.Namespace},
+		{&d.Duration, &other.Duration},
+		{&d.Wait, &other.Wait},
+		{&d.RequestName, &other.RequestName},
+		{&d.Output, &other.Output},
+	} {
+		if *s.dst == "" {
+			*s.dst = *s.src
+		}
+	}
+	return d
+}
+
+// flagValues maps the name of each flag to the value it should default to.
+func (d configDefaults) flagValues() map[string]string {
+	return map[string]string{
+		"namespace":    d.Namespace,
+		"duration":     d.Duration,
+		"wait":         d.Wait,
+		"request-name": d.RequestName,
+		"output":       d.Output,
+	}
+}
+
+// envDefaults returns the settings supplied through the environment.
+func envDefaults() configDefaults {
+	return configDefaults{
+		Namespace:   os.Getenv(envNamespace),
+		Duration:    os.Getenv(envDuration),
+		Wait:        os.Getenv(envWait),
+		RequestName: os.Getenv(envRequestName),
+		Output:      os.Getenv(envOutput),
+	}
+}
+
+// applyConfig sets the flags of the command that were not supplied by the
+// user from - in order of precedence - the alias named by the first argument,
+// the environment, and the config file. If an alias is used, the first
+// argument is replaced with the name of its template, in place, so that the
+// PreRunE and Run functions of the command see the real template name.
+func applyConfig(cmd *cobra.Command, args []string, cfg ozctlConfig) error {
+	settings := envDefaults().merge(cfg.configDefaults)
+
+	if kind, ok := cmd.Annotations[templateKindAnnotation]; ok && len(args) > 0 {
+		if alias, ok := cfg.Aliases[args[0]]; ok {
+			if alias.Kind != "" && alias.Kind != kind {
+				return fmt.Errorf("alias %q refers to a template of kind %s, but %s expects kind %s",
+					args[0], alias.Kind, cmd.CommandPath(), kind)
+			}
+			args[0] = alias.Template
+			settings = alias.configDefaults.merge(settings)
+		}
+	}
+
+	for name, value := range settings.flagValues() {
+		if value == "" {
+			continue
+		}
+		f := cmd.Flags().Lookup(name)
+		if f == nil || f.Changed {
+			continue
+		}
+		if err := f.Value.Set(value); err != nil {
+			return fmt.Errorf("invalid default for --%s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	personal := writeConfigFile(t, `
+duration: 30m
+aliases:
+  shell:
+    template: my-shell
+`)
+	team := writeConfigFile(t, `
+namespace: payments
+duration: 2h
+aliases:
+  shell:
+    template: team-shell
+  toolbox:
+    kind: PodAccessTemplate
+    template: payments-toolbox
+    wait: 5m
+`)
+
+	cfg, err := loadConfig([]string{personal, filepath.Join(t.TempDir(), "missing.yaml"), team})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The first file to set a value wins
+	if cfg.Duration != "30m" || cfg.Namespace != "payments" {
+		t.Errorf("unexpected defaults: %+v", cfg.configDefaults)
+	}
+	if cfg.Aliases["shell"].Template != "my-shell" {
+		t.Errorf("expected the personal alias to win, got %+v", cfg.Aliases["shell"])
+	}
+	if a := cfg.Aliases["toolbox"]; a.Template != "payments-toolbox" || a.Wait != "5m" {
+		t.Errorf("expected the team alias to be loaded, got %+v", a)
+	}
+
+	// Typos are not silently ignored
+	if _, err := loadConfig([]string{writeConfigFile(t, "durration: 1h\n")}); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+	if _, err := loadConfig([]string{writeConfigFile(t, "aliases:\n  x:\n    kind: PodAccessTemplate\n")}); err == nil {
+		t.Error("expected an error for an alias without a template")
+	}
+}
+
+func TestApplyConfig(t *testing.T) {
+	var flagDuration, flagWait, flagOutput string
+	newCmd := func() *cobra.Command {
+		c := &cobra.Command{
+			Use:         "test",
+			Annotations: map[string]string{templateKindAnnotation: "PodAccessTemplate"},
+		}
+		c.Flags().StringVar(&flagDuration, "duration", "", "")
+		c.Flags().StringVar(&flagWait, "wait", "1m", "")
+		c.Flags().StringVar(&flagOutput, "output", "text", "")
+		return c
+	}
+	cfg := ozctlConfig{
+		configDefaults: configDefaults{Duration: "2h", Wait: "3m", Output: "json"},
+		Aliases: map[string]configAlias{
+			"toolbox": {Template: "payments-toolbox", configDefaults: configDefaults{Duration: "30m"}},
+			"shell":   {Template: "payments-shell", Kind: "ExecAccessTemplate"},
+		},
+	}
+	t.Setenv(envWait, "4m")
+
+	// Flags beat aliases, which beat the environment, which beats the file
+	c := newCmd()
+	if err := c.ParseFlags([]string{"--output", "text"}); err != nil {
+		t.Fatal(err)
+	}
+	args := []string{"toolbox", "--", "ls"}
+	if err := applyConfig(c, args, cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if args[0] != "payments-toolbox" {
+		t.Errorf("expected the alias to be replaced by its template, got %s", args[0])
+	}
+	if flagDuration != "30m" || flagWait != "4m" || flagOutput != "text" {
+		t.Errorf("unexpected flag values: duration=%s wait=%s output=%s", flagDuration, flagWait, flagOutput)
+	}
+
+	// Without an alias, the config file provides the duration
+	c = newCmd()
+	args = []string{"some-template"}
+	if err := applyConfig(c, args, cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if args[0] != "some-template" || flagDuration != "2h" || flagOutput != "json" {
+		t.Errorf("unexpected result: args=%v duration=%s output=%s", args, flagDuration, flagOutput)
+	}
+
+	// Aliases for another kind of template are refused
+	err := applyConfig(newCmd(), []string{"shell"}, cfg)
+	if err == nil || !strings.Contains(err.Error(), "ExecAccessTemplate") {
+		t.Errorf("expected a kind mismatch error, got %v", err)
+	}
+}
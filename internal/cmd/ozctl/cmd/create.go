@@ -15,15 +15,21 @@ const (
 	OutputFormatTable = "table"
 )
 
-// outputFormat holds the output format for create commands (json, yaml, text)
-var outputFormat = OutputFormatText
-
 var createExample = `
 # Create an ExecAccessRequest with ExecAccessTemplate "some-template"
 ozctl create ExecAccessRequest --target some-template
 
 # Create a PodAccessRequest with PodAccessTemplate "some-template"
 ozctl create PodAccessRequest --target some-template
+
+# Create an EphemeralContainerAccessRequest with EphemeralContainerAccessTemplate "some-template"
+ozctl create EphemeralContainerAccessRequest --target some-template
+
+# Create a LogAccessRequest with LogAccessTemplate "some-template"
+ozctl create LogAccessRequest --target some-template
+
+# Create a PortForwardAccessRequest with PortForwardAccessTemplate "some-template"
+ozctl create PortForwardAccessRequest --target some-template
 `
 
 var createCmd = &cobra.Command{
@@ -47,7 +53,5 @@ var createCmd = &cobra.Command{
 }
 
 func init() {
-	createCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", OutputFormatText,
-		"Output format: json, yaml, or text")
 	rootCmd.AddCommand(createCmd)
 }
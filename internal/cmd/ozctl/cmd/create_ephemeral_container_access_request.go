@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+var createEphemeralContainerAccessRequestExample = `
+An EphemeralContainerAccessRequest injects a debug container into a randomly
+selected target Pod for you:
+$ ozctl create EphemeralContainerAccessRequest <existing template>
+...
+Success, your access request is ready! Here are your access instructions:
+
+kubectl attach -ti -n default my-app-7d9f8b6c5-x2k4p -c oz-debug-4b8f2a1c
+
+You can optionally target a specific Pod:
+$ ozctl create EphemeralContainerAccessRequest <existing template> --target-pod my-existing-pod
+...
+`
+
+// Holder for the values of the --wait and --output flags
+var createEphemeralContainerAccessRequestOpts requestOptions
+
+// createEphemeralContainerAccessRequestCmd represents the create command
+var createEphemeralContainerAccessRequestCmd = &cobra.Command{
+	Aliases: []string{
+		"ephemeralcontaineraccessrequest",
+		"ephemeralcontaineraccessrequests",
+		"ephemeral-container-access-request",
+		"debug",
+	},
+	Use:         "EphemeralContainerAccessRequest <EphemeralContainerAccessTemplate Name>",
+	Annotations: map[string]string{templateKindAnnotation: "EphemeralContainerAccessTemplate"},
+	Short:       "Create EphemeralContainerAccessRequest resources",
+	Example:     createEphemeralContainerAccessRequestExample,
+	Args:        cobra.MinimumNArgs(1),
+
+	// Static validation of the inputs - cannot be used to set state in the Run function.
+	PreRunE: func(_ *cobra.Command, _ []string) error {
+		// Request name prefix must start with letters a-z, can contain dashes, and must end in a
+		// letter or number.
+		re, err := regexp.Compile(`^[a-z][a-z0-9-][a-z0-9]+`)
+		if err != nil {
+			return err
+		}
+		if !re.MatchString(requestNamePrefix) {
+			return fmt.Errorf("invalid request name prefix: %s", requestNamePrefix)
+		}
+
+		// Verify the waitTime syntax
+		_, err = time.ParseDuration(createEphemeralContainerAccessRequestOpts.waitTime)
+		if err != nil {
+			return fmt.Errorf("invalid time supplied: %s", createEphemeralContainerAccessRequestOpts.waitTime)
+		}
+
+		return nil
+	},
+
+	// Do the thing
+	Run: func(cmd *cobra.Command, args []string) {
+		// The template must be the first argument.
+		template := args[0]
+
+		// Get our k8s client and namespace
+		_, namespace := getKubeClient()
+
+		// Create a dynamically named request template
+		req := &api.EphemeralContainerAccessRequest{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "EphemeralContainerAccessRequest",
+				APIVersion: api.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: fmt.Sprintf("%s-", requestNamePrefix),
+				Namespace:    namespace,
+			},
+			Spec: api.EphemeralContainerAccessRequestSpec{
+				TemplateName: template,
+				Duration:     duration,
+				TargetPod:    targetPod,
+			},
+		}
+
+		// Verify that the target template exists proactively before creating the resource
+		verifyTemplate(cmd, req, createEphemeralContainerAccessRequestOpts)
+
+		// Create the request resource itself now
+		createAccessRequest(cmd, req, createEphemeralContainerAccessRequestOpts)
+
+		// Wait until the access request is ready
+		waitForAccessRequest(cmd, req, createEphemeralContainerAccessRequestOpts)
+	},
+}
+
+func init() {
+	createEphemeralContainerAccessRequestCmd.Flags().
+		StringVarP(&targetPod, "target-pod", "p", "", "Optional name of a specific target pod to request access for")
+	createEphemeralContainerAccessRequestCmd.Flags().
+		StringVarP(&duration, "duration", "D", "", "Duration for the access request to be valid. Valid time units are: ns, us, ms, s, m, h.")
+	createEphemeralContainerAccessRequestCmd.Flags().
+		StringVarP(&createEphemeralContainerAccessRequestOpts.waitTime, "wait", "w", "1m", "Duration to wait for the access request to be fully ready. Valid time units are: ns, us, ms, s, m, h.")
+	createEphemeralContainerAccessRequestCmd.Flags().
+		StringVarP(&createEphemeralContainerAccessRequestOpts.outputFormat, "output", "o", OutputFormatText, "Output format: json, yaml, or text")
+	createEphemeralContainerAccessRequestCmd.Flags().
+		StringVarP(&requestNamePrefix, "request-name", "N", "", "Prefix name to use when creating the `EphemeralContainerAccessRequest` objects. Defaults to your Kubernetes username.")
+
+	kubeConfigFlags.AddFlags(createEphemeralContainerAccessRequestCmd.Flags())
+
+	createCmd.AddCommand(createEphemeralContainerAccessRequestCmd)
+}
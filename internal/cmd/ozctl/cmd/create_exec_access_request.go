@@ -18,11 +18,9 @@ var (
 	// Holder for the value of the --duration flag
 	duration = "1h"
 
-	// The prefix used in the Metadata.Name field for the ExecAccessRequest object.
-	requestNamePrefix = "unknown"
-
-	// Time to wait for ExecAccessRequest to be approved and ready for use.
-	waitTime = "10s"
+	// The prefix used in the Metadata.Name field for the ExecAccessRequest
+	// object. When not supplied, it is derived from the Kubernetes username.
+	requestNamePrefix = ""
 )
 
 var createExecAccessRequestExample = `
@@ -35,13 +33,17 @@ $ ozctl create ExecAccessRequest <existing template> --targetPod my-existing-pod
 ...
 `
 
+// Holder for the values of the --wait and --output flags
+var createExecAccessRequestOpts requestOptions
+
 // createAccessRequestCmd represents the create command
 var createExecAccessRequestCmd = &cobra.Command{
-	Aliases: []string{"execaccessrequest", "execaccessrequests", "exec-access-request", "exec"},
-	Use:     "ExecAccessRequest <ExecAccessTemplate Name>",
-	Short:   "Create ExecAccessRequest resources",
-	Example: createExecAccessRequestExample,
-	Args:    cobra.MinimumNArgs(1),
+	Aliases:     []string{"execaccessrequest", "execaccessrequests", "exec-access-request", "exec"},
+	Use:         "ExecAccessRequest <ExecAccessTemplate Name>",
+	Annotations: map[string]string{templateKindAnnotation: "ExecAccessTemplate"},
+	Short:       "Create ExecAccessRequest resources",
+	Example:     createExecAccessRequestExample,
+	Args:        cobra.MinimumNArgs(1),
 
 	// Static validation of the inputs - cannot be used to set state in the Run function.
 	PreRunE: func(_ *cobra.Command, _ []string) error {
@@ -56,9 +58,9 @@ var createExecAccessRequestCmd = &cobra.Command{
 		}
 
 		// Verify the waitTime syntax
-		_, err = time.ParseDuration(waitTime)
+		_, err = time.ParseDuration(createExecAccessRequestOpts.waitTime)
 		if err != nil {
-			return fmt.Errorf("invalid time supplied: %s", waitTime)
+			return fmt.Errorf("invalid time supplied: %s", createExecAccessRequestOpts.waitTime)
 		}
 
 		return nil
@@ -90,13 +92,13 @@ var createExecAccessRequestCmd = &cobra.Command{
 		}
 
 		// Verify that the target template exists proactively before creating the resource
-		verifyTemplate(cmd, req)
+		verifyTemplate(cmd, req, createExecAccessRequestOpts)
 
 		// Create the request resource itself now
-		createAccessRequest(cmd, req)
+		createAccessRequest(cmd, req, createExecAccessRequestOpts)
 
 		// Wait until the access request is ready
-		waitForAccessRequest(cmd, req)
+		waitForAccessRequest(cmd, req, createExecAccessRequestOpts)
 	},
 }
 
@@ -106,9 +108,11 @@ func init() {
 	createExecAccessRequestCmd.Flags().
 		StringVarP(&duration, "duration", "D", "", "Duration for the access request to be valid. Valid time units are: ns, us, ms, s, m, h.")
 	createExecAccessRequestCmd.Flags().
-		StringVarP(&waitTime, "wait", "w", "1m", "Duration to wait for the access request to be fully ready. Valid time units are: ns, us, ms, s, m, h.")
+		StringVarP(&createExecAccessRequestOpts.waitTime, "wait", "w", "1m", "Duration to wait for the access request to be fully ready. Valid time units are: ns, us, ms, s, m, h.")
+	createExecAccessRequestCmd.Flags().
+		StringVarP(&createExecAccessRequestOpts.outputFormat, "output", "o", OutputFormatText, "Output format: json, yaml, or text")
 	createExecAccessRequestCmd.Flags().
-		StringVarP(&requestNamePrefix, "request-name", "N", usernameEnv, "Prefix name to use when creating the `ExecAccessRequest` objects.")
+		StringVarP(&requestNamePrefix, "request-name", "N", "", "Prefix name to use when creating the `ExecAccessRequest` objects. Defaults to your Kubernetes username.")
 
 	kubeConfigFlags.AddFlags(createExecAccessRequestCmd.Flags())
 
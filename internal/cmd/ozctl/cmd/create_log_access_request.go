@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+var createLogAccessRequestExample = `
+A LogAccessRequest grants read access to the logs of all of the Pods of the
+target controller:
+
+$ ozctl create LogAccessRequest <existing template>
+...
+Success, your access request is ready! Here are your access instructions:
+
+kubectl logs -n default -l app=example --prefix
+`
+
+// Holder for the values of the --wait and --output flags
+var createLogAccessRequestOpts requestOptions
+
+// createLogAccessRequestCmd represents the create command
+var createLogAccessRequestCmd = &cobra.Command{
+	Aliases:     []string{"logaccessrequest", "logaccessrequests", "log-access-request", "logs"},
+	Use:         "LogAccessRequest <LogAccessTemplate Name>",
+	Annotations: map[string]string{templateKindAnnotation: "LogAccessTemplate"},
+	Short:       "Create LogAccessRequest resources",
+	Example:     createLogAccessRequestExample,
+	Args:        cobra.MinimumNArgs(1),
+
+	// Static validation of the inputs - cannot be used to set state in the Run function.
+	PreRunE: func(_ *cobra.Command, _ []string) error {
+		// Request name prefix must start with letters a-z, can contain dashes, and must end in a
+		// letter or number.
+		re, err := regexp.Compile(`^[a-z][a-z0-9-][a-z0-9]+`)
+		if err != nil {
+			return err
+		}
+		if !re.MatchString(requestNamePrefix) {
+			return fmt.Errorf("invalid request name prefix: %s", requestNamePrefix)
+		}
+
+		// Verify the waitTime syntax
+		_, err = time.ParseDuration(createLogAccessRequestOpts.waitTime)
+		if err != nil {
+			return fmt.Errorf("invalid time supplied: %s", createLogAccessRequestOpts.waitTime)
+		}
+
+		return nil
+	},
+
+	// Do the thing
+	Run: func(cmd *cobra.Command, args []string) {
+		// The template must be the first argument.
+		template := args[0]
+
+		// Get our k8s client and namespace
+		_, namespace := getKubeClient()
+
+		// Create a dynamically named request template
+		req := &api.LogAccessRequest{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "LogAccessRequest",
+				APIVersion: api.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: fmt.Sprintf("%s-", requestNamePrefix),
+				Namespace:    namespace,
+			},
+			Spec: api.LogAccessRequestSpec{
+				TemplateName: template,
+				Duration:     duration,
+			},
+		}
+
+		// Verify that the target template exists proactively before creating the resource
+		verifyTemplate(cmd, req, createLogAccessRequestOpts)
+
+		// Create the request resource itself now
+		createAccessRequest(cmd, req, createLogAccessRequestOpts)
+
+		// Wait until the access request is ready
+		waitForAccessRequest(cmd, req, createLogAccessRequestOpts)
+	},
+}
+
+func init() {
+	createLogAccessRequestCmd.Flags().
+		StringVarP(&duration, "duration", "D", "", "Duration for the access request to be valid. Valid time units are: ns, us, ms, s, m, h.")
+	createLogAccessRequestCmd.Flags().
+		StringVarP(&createLogAccessRequestOpts.waitTime, "wait", "w", "1m", "Duration to wait for the access request to be fully ready. Valid time units are: ns, us, ms, s, m, h.")
+	createLogAccessRequestCmd.Flags().
+		StringVarP(&createLogAccessRequestOpts.outputFormat, "output", "o", OutputFormatText, "Output format: json, yaml, or text")
+	createLogAccessRequestCmd.Flags().
+		StringVarP(&requestNamePrefix, "request-name", "N", "", "Prefix name to use when creating the `LogAccessRequest` objects. Defaults to your Kubernetes username.")
+
+	kubeConfigFlags.AddFlags(createLogAccessRequestCmd.Flags())
+
+	createCmd.AddCommand(createLogAccessRequestCmd)
+}
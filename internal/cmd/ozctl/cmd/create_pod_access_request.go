@@ -6,11 +6,20 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/diranged/oz/internal/api/v1alpha1"
 )
 
+// Resource requests for the default container of the Pod, if supplied
+var (
+	cpu     string
+	memory  string
+	storage string
+)
+
 var createPodAccessRequestExample = `
 A PodAccessRequest always generates a new Pod for you to do your work in. You simply run:
 
@@ -19,15 +28,25 @@ $ ozctl create PodAccessRequest <existing template>
 Success, your access request is ready! Here are your access instructions:
 
 kubectl exec -ti -n default user-vd9r9-a217f263 -- /bin/sh
+
+Heavier work may need more resources than the template provides by default.
+You can ask for more, up to the maxCpu, maxMemory and maxStorage of the
+template:
+
+$ ozctl create PodAccessRequest <existing template> --cpu 4 --memory 8Gi
 `
 
+// Holder for the values of the --wait and --output flags
+var createPodAccessRequestOpts requestOptions
+
 // createPodAccessRequestCmd represents the create command
 var createPodAccessRequestCmd = &cobra.Command{
-	Aliases: []string{"podaccessrequest", "podaccessrequests", "pod-access-request", "pod"},
-	Use:     "PodAccessRequest <PodAccessTemplate Name>",
-	Short:   "Create PodAccessRequest resources",
-	Example: createPodAccessRequestExample,
-	Args:    cobra.MinimumNArgs(1),
+	Aliases:     []string{"podaccessrequest", "podaccessrequests", "pod-access-request", "pod"},
+	Use:         "PodAccessRequest <PodAccessTemplate Name>",
+	Annotations: map[string]string{templateKindAnnotation: "PodAccessTemplate"},
+	Short:       "Create PodAccessRequest resources",
+	Example:     createPodAccessRequestExample,
+	Args:        cobra.MinimumNArgs(1),
 
 	// Static validation of the inputs - cannot be used to set state in the Run function.
 	PreRunE: func(_ *cobra.Command, _ []string) error {
@@ -42,9 +61,14 @@ var createPodAccessRequestCmd = &cobra.Command{
 		}
 
 		// Verify the waitTime syntax
-		_, err = time.ParseDuration(waitTime)
+		_, err = time.ParseDuration(createPodAccessRequestOpts.waitTime)
 		if err != nil {
-			return fmt.Errorf("invalid time supplied: %s", waitTime)
+			return fmt.Errorf("invalid time supplied: %s", createPodAccessRequestOpts.waitTime)
+		}
+
+		// Verify the resource quantities
+		if _, err := podResources(); err != nil {
+			return err
 		}
 
 		return nil
@@ -73,15 +97,16 @@ var createPodAccessRequestCmd = &cobra.Command{
 				Duration:     duration,
 			},
 		}
+		req.Spec.Resources, _ = podResources()
 
 		// Verify that the target template exists proactively before creating the resource
-		verifyTemplate(cmd, req)
+		verifyTemplate(cmd, req, createPodAccessRequestOpts)
 
 		// Create the request resource itself now
-		createAccessRequest(cmd, req)
+		createAccessRequest(cmd, req, createPodAccessRequestOpts)
 
 		// Wait until the access request is ready
-		waitForAccessRequest(cmd, req)
+		waitForAccessRequest(cmd, req, createPodAccessRequestOpts)
 	},
 }
 
@@ -89,11 +114,45 @@ func init() {
 	createPodAccessRequestCmd.Flags().
 		StringVarP(&duration, "duration", "D", "", "Duration for the access request to be valid. Valid time units are: ns, us, ms, s, m, h.")
 	createPodAccessRequestCmd.Flags().
-		StringVarP(&waitTime, "wait", "w", "5m", "Duration to wait for the access request to be fully ready. Valid time units are: ns, us, ms, s, m, h.")
+		StringVarP(&createPodAccessRequestOpts.waitTime, "wait", "w", "5m", "Duration to wait for the access request to be fully ready. Valid time units are: ns, us, ms, s, m, h.")
 	createPodAccessRequestCmd.Flags().
-		StringVarP(&requestNamePrefix, "request-name", "N", usernameEnv, "Prefix name to use when creating the `AccessRequest` objects.")
+		StringVarP(&createPodAccessRequestOpts.outputFormat, "output", "o", OutputFormatText, "Output format: json, yaml, or text")
+	createPodAccessRequestCmd.Flags().
+		StringVarP(&requestNamePrefix, "request-name", "N", "", "Prefix name to use when creating the `AccessRequest` objects. Defaults to your Kubernetes username.")
+
+	createPodAccessRequestCmd.Flags().
+		StringVar(&cpu, "cpu", "", "CPU to request for the Pod, eg. 2 or 500m. Must not exceed the maxCpu of the template.")
+	createPodAccessRequestCmd.Flags().
+		StringVar(&memory, "memory", "", "Memory to request for the Pod, eg. 4Gi. Must not exceed the maxMemory of the template.")
+	createPodAccessRequestCmd.Flags().
+		StringVar(&storage, "storage", "", "Ephemeral storage to request for the Pod, eg. 10Gi. Must not exceed the maxStorage of the template.")
 
 	kubeConfigFlags.AddFlags(createPodAccessRequestCmd.Flags())
 
 	createCmd.AddCommand(createPodAccessRequestCmd)
 }
+
+// podResources returns the Spec.resources for the PodAccessRequest based on
+// the --cpu, --memory and --storage flags, or nil if none were supplied.
+func podResources() (*corev1.ResourceRequirements, error) {
+	requests := corev1.ResourceList{}
+	for name, value := range map[corev1.ResourceName]string{
+		corev1.ResourceCPU:              cpu,
+		corev1.ResourceMemory:           memory,
+		corev1.ResourceEphemeralStorage: storage,
+	} {
+		if value == "" {
+			continue
+		}
+		qty, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s quantity supplied: %s", name, value)
+		}
+		requests[name] = qty
+	}
+
+	if len(requests) == 0 {
+		return nil, nil
+	}
+	return &corev1.ResourceRequirements{Requests: requests}, nil
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestPodResources(t *testing.T) {
+	defer func() { cpu, memory, storage = "", "", "" }()
+
+	// No flags means no Spec.resources at all
+	cpu, memory, storage = "", "", ""
+	res, err := podResources()
+	if err != nil || res != nil {
+		t.Fatalf("expected nil resources and no error, got %v, %v", res, err)
+	}
+
+	// Only the supplied flags are requested
+	cpu, memory = "4", "8Gi"
+	res, err = podResources()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := res.Requests.Cpu().String(); got != "4" {
+		t.Errorf("expected cpu 4, got %s", got)
+	}
+	if got := res.Requests.Memory().String(); got != "8Gi" {
+		t.Errorf("expected memory 8Gi, got %s", got)
+	}
+	if _, ok := res.Requests[corev1.ResourceEphemeralStorage]; ok {
+		t.Errorf("expected no ephemeral-storage request")
+	}
+	if res.Limits != nil {
+		t.Errorf("expected no limits, got %v", res.Limits)
+	}
+
+	// Invalid quantities are rejected
+	storage = "lots"
+	if _, err = podResources(); err == nil {
+		t.Errorf("expected an error for an invalid quantity")
+	}
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+// Holder for the optional --port flags
+var ports []int32
+
+var createPortForwardAccessRequestExample = `
+By default, a PortForwardAccessRequest randomly selects a target Pod and
+forwards all of the ports allowed by the template:
+$ ozctl create PortForwardAccessRequest <existing template>
+...
+Success, your access request is ready! Here are your access instructions:
+
+kubectl port-forward -n default my-app-7d9f8b6c5-x2k4p 5432:5432
+
+You can optionally target a specific Pod, and only forward some of the allowed ports:
+$ ozctl create PortForwardAccessRequest <existing template> --target-pod my-existing-pod --port 5432
+...
+`
+
+// Holder for the values of the --wait and --output flags
+var createPortForwardAccessRequestOpts requestOptions
+
+// createPortForwardAccessRequestCmd represents the create command
+var createPortForwardAccessRequestCmd = &cobra.Command{
+	Aliases: []string{
+		"portforwardaccessrequest",
+		"portforwardaccessrequests",
+		"port-forward-access-request",
+		"port-forward",
+	},
+	Use:         "PortForwardAccessRequest <PortForwardAccessTemplate Name>",
+	Annotations: map[string]string{templateKindAnnotation: "PortForwardAccessTemplate"},
+	Short:       "Create PortForwardAccessRequest resources",
+	Example:     createPortForwardAccessRequestExample,
+	Args:        cobra.MinimumNArgs(1),
+
+	// Static validation of the inputs - cannot be used to set state in the Run function.
+	PreRunE: func(_ *cobra.Command, _ []string) error {
+		// Request name prefix must start with letters a-z, can contain dashes, and must end in a
+		// letter or number.
+		re, err := regexp.Compile(`^[a-z][a-z0-9-][a-z0-9]+`)
+		if err != nil {
+			return err
+		}
+		if !re.MatchString(requestNamePrefix) {
+			return fmt.Errorf("invalid request name prefix: %s", requestNamePrefix)
+		}
+
+		// Verify the waitTime syntax
+		_, err = time.ParseDuration(createPortForwardAccessRequestOpts.waitTime)
+		if err != nil {
+			return fmt.Errorf("invalid time supplied: %s", createPortForwardAccessRequestOpts.waitTime)
+		}
+
+		// Verify the ports are valid port numbers
+		for _, port := range ports {
+			if port < 1 || port > 65535 {
+				return fmt.Errorf("invalid port supplied: %d", port)
+			}
+		}
+
+		return nil
+	},
+
+	// Do the thing
+	Run: func(cmd *cobra.Command, args []string) {
+		// The template must be the first argument.
+		template := args[0]
+
+		// Get our k8s client and namespace
+		_, namespace := getKubeClient()
+
+		// Create a dynamically named request template
+		req := &api.PortForwardAccessRequest{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "PortForwardAccessRequest",
+				APIVersion: api.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: fmt.Sprintf("%s-", requestNamePrefix),
+				Namespace:    namespace,
+			},
+			Spec: api.PortForwardAccessRequestSpec{
+				TemplateName: template,
+				Duration:     duration,
+				TargetPod:    targetPod,
+				Ports:        ports,
+			},
+		}
+
+		// Verify that the target template exists proactively before creating the resource
+		verifyTemplate(cmd, req, createPortForwardAccessRequestOpts)
+
+		// Create the request resource itself now
+		createAccessRequest(cmd, req, createPortForwardAccessRequestOpts)
+
+		// Wait until the access request is ready
+		waitForAccessRequest(cmd, req, createPortForwardAccessRequestOpts)
+	},
+}
+
+func init() {
+	createPortForwardAccessRequestCmd.Flags().
+		StringVarP(&targetPod, "target-pod", "p", "", "Optional name of a specific target pod to request access for")
+	createPortForwardAccessRequestCmd.Flags().
+		Int32SliceVarP(&ports, "port", "P", nil, "Port to forward, may be repeated. Defaults to all of the ports allowed by the template.")
+	createPortForwardAccessRequestCmd.Flags().
+		StringVarP(&duration, "duration", "D", "", "Duration for the access request to be valid. Valid time units are: ns, us, ms, s, m, h.")
+	createPortForwardAccessRequestCmd.Flags().
+		StringVarP(&createPortForwardAccessRequestOpts.waitTime, "wait", "w", "1m", "Duration to wait for the access request to be fully ready. Valid time units are: ns, us, ms, s, m, h.")
+	createPortForwardAccessRequestCmd.Flags().
+		StringVarP(&createPortForwardAccessRequestOpts.outputFormat, "output", "o", OutputFormatText, "Output format: json, yaml, or text")
+	createPortForwardAccessRequestCmd.Flags().
+		StringVarP(&requestNamePrefix, "request-name", "N", "", "Prefix name to use when creating the `PortForwardAccessRequest` objects. Defaults to your Kubernetes username.")
+
+	kubeConfigFlags.AddFlags(createPortForwardAccessRequestCmd.Flags())
+
+	createCmd.AddCommand(createPortForwardAccessRequestCmd)
+}
@@ -3,103 +3,118 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"time"
 
 	"github.com/spf13/cobra"
-	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
 	"github.com/diranged/oz/internal/api/v1alpha1"
 )
 
-func createAccessRequest(cmd *cobra.Command, req v1alpha1.IRequestResource) {
+// requestOptions holds the flags that control how a command creates an
+// Access Request, and waits for it to be ready. Every command binds its own
+// in its init(), so the flag defaults of one command never leak into another.
+type requestOptions struct {
+	// Time to wait for the request to be approved and ready for use
+	waitTime string
+
+	// Format of the output: text, json or yaml
+	outputFormat string
+}
+
+func createAccessRequest(cmd *cobra.Command, req v1alpha1.IRequestResource, opts requestOptions) {
 	// Get our Kubernetes Client
-	client, _ := getKubeClient()
+	cl, _ := getKubeClient()
 
+	if err := submitAccessRequest(cmd, cl, req, opts); err != nil {
+		fmt.Printf(
+			logError("Error - Creating %s failed:\n  %s\n"),
+			req.GetObjectKind().GroupVersionKind().GroupKind().Kind,
+			err,
+		)
+		os.Exit(1)
+	}
+}
+
+// submitAccessRequest creates the request. Unlike createAccessRequest, it
+// returns the error rather than exiting - so callers can report it in their
+// own way.
+func submitAccessRequest(
+	cmd *cobra.Command,
+	cl client.Client,
+	req v1alpha1.IRequestResource,
+	opts requestOptions,
+) error {
 	// Pretty-print the type of object we're creating...
 	reqKind := req.GetObjectKind().GroupVersionKind().GroupKind().Kind
-	if outputFormat == OutputFormatText {
+	if opts.outputFormat == OutputFormatText {
 		cmd.Printf(logNotice("Creating %s... "), reqKind)
 	}
 
 	// Make the calls to create the request
-	if err := client.Create(cmd.Context(), req); err != nil {
-		fmt.Printf(
-			logError("Error - Creating %s failed:\n  %s\n"),
-			reqKind,
-			err,
-		)
-		os.Exit(1)
+	if err := cl.Create(cmd.Context(), req); err != nil {
+		return err
 	}
 
-	if outputFormat == OutputFormatText {
+	if opts.outputFormat == OutputFormatText {
 		cmd.Printf(logNotice("%s created!\n"), req.GetName())
 	}
+	return nil
 }
 
-func waitForAccessRequest(cmd *cobra.Command, req v1alpha1.IRequestResource) {
-	// Cast the ICoreStatus interface into an IRequestStatus interface
-	status := req.GetStatus().(v1alpha1.IRequestStatus)
+func waitForAccessRequest(cmd *cobra.Command, req v1alpha1.IRequestResource, opts requestOptions) {
+	if err := awaitAccessRequest(cmd, req, opts); err != nil {
+		fmt.Printf(logError("\nError - %s\n"), err)
+		printConditions(cmd, req)
+		os.Exit(1)
+	}
+	printOutput(cmd, req, opts)
+}
 
-	// Get our Kubernetes Client
-	client, _ := getKubeClient()
+// awaitAccessRequest watches the request until it is ready, or returns an
+// error once opts.waitTime has passed. Each condition transition is reported
+// as it happens. Unlike waitForAccessRequest, it does not print the request or
+// exit - so callers can clean up after a failure.
+func awaitAccessRequest(cmd *cobra.Command, req v1alpha1.IRequestResource, opts requestOptions) error {
+	cl, _ := getKubeWatchClient()
 
-	// Wait until we are either fully succesful, or we've hit our timeout.
-	if outputFormat == OutputFormatText {
-		// Newline intentionally missing.
-		cmd.Printf(logNotice("Waiting for %s to be ready"), req.GetName())
+	if opts.outputFormat == OutputFormatText {
+		cmd.Printf(logNotice("Waiting for %s to be ready...\n"), req.GetName())
 	}
 
-	// Create a timeout context... we'll use this to bail out of our loop after waitTime has been hit.
-	waitDuration, _ := time.ParseDuration(waitTime)
-	waitCtx, cancel := context.WithTimeout(context.Background(), waitDuration)
+	// Create a timeout context... we'll use this to bail out of the watch after waitTime has been hit.
+	waitDuration, _ := time.ParseDuration(opts.waitTime)
+	waitCtx, cancel := context.WithTimeout(cmd.Context(), waitDuration)
 	defer cancel()
-	for {
-		// At the beginning of each loop, update the client object from the API. If we see an
-		// error, log it .. but just continue and try again.
-		if err := client.Get(cmd.Context(), types.NamespacedName{
-			Name:      req.GetName(),
-			Namespace: req.GetNamespace(),
-		}, req); err != nil {
-			if outputFormat == OutputFormatText {
-				cmd.Printf(logWarning("\nError updating request status: %s\n"), err)
-			}
-			continue
-		}
-
-		// Check the status
-		if status.IsReady() {
-			printOutput(cmd, req)
-			return
-		}
 
-		if waitCtx.Err() != nil {
-			fmt.Printf(logError("\nError - timed out waiting for %s to be ready\n"), req.GetName())
-			for _, cond := range *status.GetConditions() {
-				cmd.Printf(
-					"Condition %s, State: %s, Reason: %s, Message: %s\n",
-					cond.Type,
-					cond.Status,
-					cond.Reason,
-					cond.Message,
-				)
-			}
-			os.Exit(1)
-		}
+	err := newRequestWatcher(cmd, cl, req, opts.outputFormat).wait(waitCtx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for %s to be ready", req.GetName())
+	}
+	return err
+}
 
-		// See if we've run out of time or not. If we have, bail out.
-		if outputFormat == OutputFormatText {
-			cmd.Print(logNotice("."))
-		}
-		time.Sleep(time.Duration(1 * time.Second))
+// printConditions prints the status conditions of the request, to help the
+// user understand why it never became ready.
+func printConditions(cmd *cobra.Command, req v1alpha1.IRequestResource) {
+	for _, cond := range *req.GetStatus().GetConditions() {
+		cmd.Printf(
+			"Condition %s, State: %s, Reason: %s, Message: %s\n",
+			cond.Type,
+			cond.Status,
+			cond.Reason,
+			cond.Message,
+		)
 	}
 }
 
-// printOutput prints the request resource in the format specified by outputFormat
-func printOutput(cmd *cobra.Command, req v1alpha1.IRequestResource) {
-	switch outputFormat {
+// printOutput prints the request resource in the format specified by opts.outputFormat
+func printOutput(cmd *cobra.Command, req v1alpha1.IRequestResource, opts requestOptions) {
+	switch opts.outputFormat {
 	case OutputFormatYAML:
 		data, err := yaml.Marshal(req)
 		if err != nil {
@@ -110,6 +125,9 @@ func printOutput(cmd *cobra.Command, req v1alpha1.IRequestResource) {
 	case OutputFormatText:
 		status := req.GetStatus().(v1alpha1.IRequestStatus)
 		cmd.Printf(successMsg, status.GetAccessMessage())
+		if expiresAt := status.GetExpiresAt(); expiresAt != nil {
+			cmd.Printf(logNotice("Access expires at %s\n"), expiresAt.Format(time.RFC3339))
+		}
 	default: // OutputFormatJSON
 		data, err := json.MarshalIndent(req, "", "  ")
 		if err != nil {
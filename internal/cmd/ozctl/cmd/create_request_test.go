@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,6 +36,7 @@ func TestPrintOutput(t *testing.T) {
 			PodName: "test-pod",
 		},
 	}
+	req.Status.SetExpiresAt(metav1.NewTime(time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)))
 
 	tests := []struct {
 		name         string
@@ -73,22 +75,20 @@ func TestPrintOutput(t *testing.T) {
 			wantContains: []string{
 				"Success",
 				"kubectl exec -ti test-pod -- /bin/sh",
+				"Access expires at 2030-01-02T03:04:05Z",
 			},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Set the output format
-			outputFormat = tt.format
-
 			// Capture the output
 			var buf bytes.Buffer
 			cmd := &cobra.Command{}
 			cmd.SetOut(&buf)
 
 			// Call printOutput
-			printOutput(cmd, req)
+			printOutput(cmd, req, requestOptions{outputFormat: tt.format})
 
 			output := buf.String()
 
@@ -119,9 +119,6 @@ func TestPrintOutput(t *testing.T) {
 }
 
 func TestPrintOutputDefaultIsText(t *testing.T) {
-	// Reset outputFormat to default
-	outputFormat = OutputFormatText
-
 	req := &api.PodAccessRequest{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "PodAccessRequest",
@@ -146,7 +143,10 @@ func TestPrintOutputDefaultIsText(t *testing.T) {
 	cmd := &cobra.Command{}
 	cmd.SetOut(&buf)
 
-	printOutput(cmd, req)
+	// The --output flag of the create commands defaults to text
+	printOutput(cmd, req, requestOptions{
+		outputFormat: createPodAccessRequestCmd.Flags().Lookup("output").DefValue,
+	})
 
 	output := buf.String()
 
@@ -163,7 +163,6 @@ func TestPrintOutputDefaultIsText(t *testing.T) {
 
 func TestPrintOutputExecAccessRequest(t *testing.T) {
 	// Test that ExecAccessRequest also works (since it implements IRequestResource)
-	outputFormat = OutputFormatJSON
 
 	req := &api.ExecAccessRequest{
 		TypeMeta: metav1.TypeMeta{
@@ -191,7 +190,7 @@ func TestPrintOutputExecAccessRequest(t *testing.T) {
 	cmd := &cobra.Command{}
 	cmd.SetOut(&buf)
 
-	printOutput(cmd, req)
+	printOutput(cmd, req, requestOptions{outputFormat: OutputFormatJSON})
 
 	output := buf.String()
 
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+var denyExample = `
+Deny an Access Request in the current namespace:
+$ ozctl deny user-abc12 --reason "please use the staging cluster"
+`
+
+var denyCmd = &cobra.Command{
+	Use:     "deny <Access Request Name>",
+	Short:   "Deny an Access Request that requires approval",
+	Example: denyExample,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		createAccessApproval(cmd, args[0], api.ApprovalDecisionDenied)
+	},
+}
+
+func init() {
+	denyCmd.Flags().
+		StringVarP(&approvalReason, "reason", "r", "", "Optional reason for the decision")
+	denyCmd.Flags().
+		StringVarP(&approvalRequestKind, "kind", "k", "", "Kind of the Access Request (eg. ExecAccessRequest), only required if the name is ambiguous")
+	kubeConfigFlags.AddFlags(denyCmd.Flags())
+	rootCmd.AddCommand(denyCmd)
+}
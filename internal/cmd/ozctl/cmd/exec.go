@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+var (
+	// Holder for the value of the --container flag
+	execContainer string
+
+	// Holder for the value of the --delete-on-exit flag
+	execDeleteOnExit bool
+
+	// Holder for the value of the --wait flag. The output is always text.
+	execOpts = requestOptions{outputFormat: OutputFormatText}
+)
+
+var execExample = `
+Request access through an ExecAccessTemplate, and open a shell in the target
+Pod as soon as it is ready:
+$ ozctl exec <existing template>
+
+Run a specific command instead of /bin/sh:
+$ ozctl exec <existing template> -- bash -l
+
+Revoke the ExecAccessRequest as soon as the shell is closed:
+$ ozctl exec <existing template> --delete-on-exit
+`
+
+var execCmd = &cobra.Command{
+	Use:         "exec <ExecAccessTemplate Name> [-- command...]",
+	Annotations: map[string]string{templateKindAnnotation: "ExecAccessTemplate"},
+	Short:       "Create an ExecAccessRequest and open a shell in the target Pod",
+	Example:     execExample,
+	Args:        cobra.MinimumNArgs(1),
+
+	// Static validation of the inputs - cannot be used to set state in the Run function.
+	PreRunE: func(_ *cobra.Command, _ []string) error {
+		// Request name prefix must start with letters a-z, can contain dashes, and must end in a
+		// letter or number.
+		re, err := regexp.Compile(`^[a-z][a-z0-9-][a-z0-9]+`)
+		if err != nil {
+			return err
+		}
+		if !re.MatchString(requestNamePrefix) {
+			return fmt.Errorf("invalid request name prefix: %s", requestNamePrefix)
+		}
+
+		// Verify the waitTime syntax
+		_, err = time.ParseDuration(execOpts.waitTime)
+		if err != nil {
+			return fmt.Errorf("invalid time supplied: %s", execOpts.waitTime)
+		}
+
+		return nil
+	},
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// The template must be the first argument, anything after it is
+		// the command to run.
+		template := args[0]
+		command := defaultExecCommand
+		if len(args) > 1 {
+			command = args[1:]
+		}
+
+		cl, namespace := getKubeClient()
+
+		req := &api.ExecAccessRequest{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ExecAccessRequest",
+				APIVersion: api.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: fmt.Sprintf("%s-", requestNamePrefix),
+				Namespace:    namespace,
+			},
+			Spec: api.ExecAccessRequestSpec{
+				TemplateName: template,
+				Duration:     duration,
+				TargetPod:    targetPod,
+			},
+		}
+
+		verifyTemplate(cmd, req, execOpts)
+		createAccessRequest(cmd, req, execOpts)
+		waitForAccessRequest(cmd, req, execOpts)
+
+		cmd.Printf(logNotice("Connecting to %s...\n"), req.Status.PodName)
+		err := execInPod(cmd.Context(), execOptions{
+			Namespace:   namespace,
+			PodName:     req.Status.PodName,
+			Container:   podContainer(cmd.Context(), cl, namespace, req.Status.PodName, execContainer),
+			Command:     command,
+			Interactive: true,
+			Stdout:      os.Stdout,
+			Stderr:      os.Stderr,
+		})
+
+		if execDeleteOnExit {
+			if revokeErr := revokeRequest(cmd.Context(), cl, req); revokeErr != nil {
+				fmt.Printf(logError("Error - Revoking %s failed:\n  %s\n"), req.GetName(), revokeErr)
+			} else {
+				cmd.Printf(logNotice("%s revoked\n"), req.GetName())
+			}
+		}
+
+		if err != nil {
+			// Pass the exit code of the remote command through
+			if code, ok := remoteExitCode(err); ok {
+				os.Exit(code)
+			}
+			fmt.Printf(logError("Error - %s\n"), err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	execCmd.Flags().
+		StringVarP(&targetPod, "target-pod", "p", "", "Optional name of a specific target pod to request access for")
+	execCmd.Flags().
+		StringVarP(&duration, "duration", "D", "", "Duration for the access request to be valid. Valid time units are: ns, us, ms, s, m, h.")
+	execCmd.Flags().
+		StringVarP(&execOpts.waitTime, "wait", "w", "1m", "Duration to wait for the access request to be fully ready. Valid time units are: ns, us, ms, s, m, h.")
+	execCmd.Flags().
+		StringVarP(&requestNamePrefix, "request-name", "N", "", "Prefix name to use when creating the `ExecAccessRequest` objects. Defaults to your Kubernetes username.")
+	execCmd.Flags().
+		StringVarP(&execContainer, "container", "c", "", "Container to run the command in, defaults to the default container of the Pod")
+	execCmd.Flags().
+		BoolVar(&execDeleteOnExit, "delete-on-exit", false, "Revoke the ExecAccessRequest once the command exits")
+
+	kubeConfigFlags.AddFlags(execCmd.Flags())
+
+	rootCmd.AddCommand(execCmd)
+}
@@ -0,0 +1,210 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"golang.org/x/term"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+// defaultExecCommand is run in the target container when the user does not
+// supply a command of their own.
+var defaultExecCommand = []string{"/bin/sh"}
+
+// execOptions describes the command to run in a Pod, and where its input and
+// output go.
+type execOptions struct {
+	Namespace string
+	PodName   string
+	Container string
+	Command   []string
+
+	// Interactive attaches the local stdin to the command. If stdin is a
+	// terminal, a TTY is allocated and the local terminal is put in raw mode
+	// until the stream closes.
+	Interactive bool
+
+	// Where the output of the command is written. With a TTY, stderr is
+	// merged into stdout by the container runtime.
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+// execInPod opens an exec stream to the container of the Pod, and runs the
+// command until it exits.
+//
+// The error from the stream is returned untouched, so that a command that
+// fails in the container surfaces as an exec.ExitError with its exit code.
+func execInPod(ctx context.Context, o execOptions) error {
+	restCfg, err := kubeConfigFlags.ToRESTConfig()
+	if err != nil {
+		return err
+	}
+	clientset, err := kubernetes.NewForConfig(restCfg)
+	if err != nil {
+		return err
+	}
+
+	stdinFd := int(os.Stdin.Fd())
+	tty := o.Interactive && term.IsTerminal(stdinFd)
+
+	req := clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(o.Namespace).
+		Name(o.PodName).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: o.Container,
+			Command:   o.Command,
+			Stdin:     o.Interactive,
+			Stdout:    true,
+			Stderr:    !tty,
+			TTY:       tty,
+		}, scheme.ParameterCodec)
+
+	// Prefer the WebSocket protocol, falling back to SPDY for older clusters
+	// (or proxies) that do not support it - just like kubectl does.
+	spdyExec, err := remotecommand.NewSPDYExecutor(restCfg, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+	wsExec, err := remotecommand.NewWebSocketExecutor(restCfg, "GET", req.URL().String())
+	if err != nil {
+		return err
+	}
+	executor, err := remotecommand.NewFallbackExecutor(wsExec, spdyExec, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	})
+	if err != nil {
+		return err
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	opts := remotecommand.StreamOptions{
+		Stdout: o.Stdout,
+		Tty:    tty,
+	}
+	if o.Interactive {
+		opts.Stdin = os.Stdin
+	}
+	if tty {
+		state, err := term.MakeRaw(stdinFd)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = term.Restore(stdinFd, state) }()
+		opts.TerminalSizeQueue = watchTerminalSize(streamCtx, int(os.Stdout.Fd()))
+	} else {
+		opts.Stderr = o.Stderr
+	}
+
+	return executor.StreamWithContext(streamCtx, opts)
+}
+
+// podContainer returns the container that the command should run in. If the
+// user did not pick one, the default container of the Pod is used. If the Pod
+// cannot be read, an empty string is returned and the API server picks.
+func podContainer(
+	ctx context.Context,
+	cl client.Client,
+	namespace string,
+	podName string,
+	container string,
+) string {
+	if container != "" {
+		return container
+	}
+	pod := &corev1.Pod{}
+	if err := cl.Get(ctx, types.NamespacedName{Name: podName, Namespace: namespace}, pod); err != nil {
+		return ""
+	}
+	return defaultContainerName(pod)
+}
+
+// terminalSizeQueue implements remotecommand.TerminalSizeQueue, passing the
+// size of the local terminal on to the remote TTY whenever it changes.
+type terminalSizeQueue struct {
+	sizes chan remotecommand.TerminalSize
+}
+
+// Next returns the next terminal size, or nil once the queue is closed.
+func (q *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-q.sizes
+	if !ok {
+		return nil
+	}
+	return &size
+}
+
+// watchTerminalSize returns a terminalSizeQueue that is populated with the
+// current size of the terminal, and again every time the terminal is resized,
+// until the context is cancelled.
+func watchTerminalSize(ctx context.Context, fd int) *terminalSizeQueue {
+	q := &terminalSizeQueue{sizes: make(chan remotecommand.TerminalSize, 1)}
+
+	resized := make(chan os.Signal, 1)
+	signal.Notify(resized, syscall.SIGWINCH)
+
+	go func() {
+		defer close(q.sizes)
+		defer signal.Stop(resized)
+		for {
+			if width, height, err := term.GetSize(fd); err == nil {
+				select {
+				case q.sizes <- remotecommand.TerminalSize{Width: uint16(width), Height: uint16(height)}:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-resized:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return q
+}
+
+// defaultContainerName returns the container that commands should be run in
+// when the user has not picked one - the container named by the
+// kubectl.kubernetes.io/default-container annotation, or else the first one.
+func defaultContainerName(pod *corev1.Pod) string {
+	if name, ok := pod.Annotations[api.DefaultContainerAnnotationKey]; ok {
+		for _, c := range pod.Spec.Containers {
+			if c.Name == name {
+				return name
+			}
+		}
+	}
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+	return ""
+}
+
+// remoteExitCode returns the exit code of the remote command, if the error
+// from execInPod carries one.
+func remoteExitCode(err error) (int, bool) {
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) && exitErr.Exited() {
+		return exitErr.ExitStatus(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilexec "k8s.io/client-go/util/exec"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+func TestDefaultContainerName(t *testing.T) {
+	containers := []corev1.Container{{Name: "app"}, {Name: "sidecar"}}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		containers  []corev1.Container
+		want        string
+	}{
+		{name: "first container", containers: containers, want: "app"},
+		{
+			name:        "annotated container",
+			annotations: map[string]string{api.DefaultContainerAnnotationKey: "sidecar"},
+			containers:  containers,
+			want:        "sidecar",
+		},
+		{
+			name:        "annotation names a missing container",
+			annotations: map[string]string{api.DefaultContainerAnnotationKey: "missing"},
+			containers:  containers,
+			want:        "app",
+		},
+		{name: "no containers", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations},
+				Spec:       corev1.PodSpec{Containers: tt.containers},
+			}
+			if got := defaultContainerName(pod); got != tt.want {
+				t.Errorf("defaultContainerName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoteExitCode(t *testing.T) {
+	if code, ok := remoteExitCode(utilexec.CodeExitError{Err: errors.New("exit"), Code: 3}); !ok || code != 3 {
+		t.Errorf("expected exit code 3, got %d (%t)", code, ok)
+	}
+	wrapped := fmt.Errorf("stream: %w", utilexec.CodeExitError{Err: errors.New("exit"), Code: 42})
+	if code, ok := remoteExitCode(wrapped); !ok || code != 42 {
+		t.Errorf("expected exit code 42, got %d (%t)", code, ok)
+	}
+	if _, ok := remoteExitCode(errors.New("connection refused")); ok {
+		t.Errorf("expected no exit code for a connection error")
+	}
+	if _, ok := remoteExitCode(nil); ok {
+		t.Errorf("expected no exit code without an error")
+	}
+}
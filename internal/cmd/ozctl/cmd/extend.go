@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+var (
+	// Holder for the value of the --by flag
+	extendBy time.Duration
+
+	// Holder for the value of the --kind flag
+	extendRequestKind string
+)
+
+var extendExample = `
+Extend an Access Request in the current namespace by another 30 minutes:
+$ ozctl extend user-abc12 --by 30m
+
+Requests can only be extended up to the maxDuration of their template,
+measured from when the request was created. Asking for more than that
+extends the request to the maximum.
+`
+
+var extendCmd = &cobra.Command{
+	Use:     "extend <Access Request Name>",
+	Short:   "Extend the duration of a live Access Request",
+	Example: extendExample,
+	Args:    cobra.ExactArgs(1),
+	PreRunE: func(_ *cobra.Command, _ []string) error {
+		if extendBy <= 0 {
+			return errors.New("--by must be a positive duration, eg. 30m")
+		}
+		return nil
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		extendAccessRequest(cmd, args[0])
+	},
+}
+
+func extendAccessRequest(cmd *cobra.Command, requestName string) {
+	cl, namespace := getKubeClient()
+
+	kind, err := findAccessRequestKind(cmd.Context(), cl, namespace, requestName, extendRequestKind)
+	if err != nil {
+		fmt.Printf(logError("Error - %s\n"), err)
+		os.Exit(1)
+	}
+
+	req := requestKinds[kind]().(api.IRequestResource)
+	if err := cl.Get(cmd.Context(), types.NamespacedName{Name: requestName, Namespace: namespace}, req); err != nil {
+		fmt.Printf(logError("Error - %s\n"), err)
+		os.Exit(1)
+	}
+
+	tmpl, err := req.GetTemplate(cmd.Context(), cl)
+	if err != nil {
+		fmt.Printf(logError("Error - %s\n"), err)
+		os.Exit(1)
+	}
+
+	newDuration, capped, err := extendedDuration(req, tmpl, extendBy)
+	if err != nil {
+		fmt.Printf(logError("Error - %s\n"), err)
+		os.Exit(1)
+	}
+	if capped {
+		cmd.Printf(
+			logWarning("Warning - %s can only be extended to the maxDuration (%s) of %s\n"),
+			requestName, newDuration, tmpl.GetName(),
+		)
+	}
+
+	// Spec.duration is always measured from the start of the access.
+	patch := fmt.Sprintf(`{"spec":{"duration":%q}}`, formatDuration(newDuration))
+	if err := cl.Patch(cmd.Context(), req, client.RawPatch(types.MergePatchType, []byte(patch))); err != nil {
+		fmt.Printf(logError("Error - Extending %s %s failed:\n  %s\n"), kind, requestName, err)
+		os.Exit(1)
+	}
+
+	cmd.Printf(
+		logSuccess("%s %s extended, access now expires at %s\n"),
+		kind, requestName,
+		req.GetStartTime().Add(newDuration).Format(time.RFC3339),
+	)
+}
+
+// extendedDuration returns the new Spec.duration for the request after
+// extending its current duration by the supplied amount. The result is capped
+// at the maxDuration of the template, in which case capped is true. An error
+// is returned if the request cannot be extended any further.
+func extendedDuration(
+	req api.IRequestResource,
+	tmpl api.ITemplateResource,
+	by time.Duration,
+) (duration time.Duration, capped bool, err error) {
+	maxDuration, err := tmpl.GetAccessConfig().GetMaxDuration()
+	if err != nil {
+		return 0, false, err
+	}
+
+	// The current duration of the request - falling back to the default of
+	// the template, just like the controller does.
+	current, err := req.GetDuration()
+	if err != nil {
+		return 0, false, err
+	}
+	if current == 0 {
+		if current, err = tmpl.GetAccessConfig().GetDefaultDuration(); err != nil {
+			return 0, false, err
+		}
+	}
+
+	if current >= maxDuration {
+		return 0, false, fmt.Errorf(
+			"%s is already at the maxDuration (%s) of %s",
+			req.GetName(), maxDuration, tmpl.GetName(),
+		)
+	}
+
+	duration = current + by
+	if duration > maxDuration {
+		return maxDuration, true, nil
+	}
+	return duration, false, nil
+}
+
+// formatDuration renders the duration in the single-unit form (eg. "90m")
+// that the Spec.duration field accepts, rounding down to whole seconds.
+func formatDuration(d time.Duration) string {
+	switch {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	default:
+		return fmt.Sprintf("%ds", d/time.Second)
+	}
+}
+
+func init() {
+	extendCmd.Flags().
+		DurationVar(&extendBy, "by", 0, "How much longer the access should last, eg. 30m")
+	extendCmd.Flags().
+		StringVarP(&extendRequestKind, "kind", "k", "", "Kind of the Access Request (eg. ExecAccessRequest), only required if the name is ambiguous")
+	_ = extendCmd.MarkFlagRequired("by")
+	kubeConfigFlags.AddFlags(extendCmd.Flags())
+	rootCmd.AddCommand(extendCmd)
+}
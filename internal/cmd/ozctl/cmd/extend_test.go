@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+func TestExtendedDuration(t *testing.T) {
+	tmpl := &api.PodAccessTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "tmpl"},
+		Spec: api.PodAccessTemplateSpec{
+			AccessConfig: api.AccessConfig{
+				DefaultDuration: "1h",
+				MaxDuration:     "4h",
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		duration   string
+		by         time.Duration
+		want       time.Duration
+		wantCapped bool
+		wantErr    string
+	}{
+		{name: "default duration", by: 30 * time.Minute, want: 90 * time.Minute},
+		{name: "custom duration", duration: "2h", by: time.Hour, want: 3 * time.Hour},
+		{name: "exactly max", duration: "2h", by: 2 * time.Hour, want: 4 * time.Hour},
+		{
+			name:       "capped at max",
+			duration:   "3h",
+			by:         2 * time.Hour,
+			want:       4 * time.Hour,
+			wantCapped: true,
+		},
+		{name: "already at max", duration: "4h", by: time.Hour, wantErr: "already at the maxDuration"},
+		{name: "invalid duration", duration: "forever", by: time.Hour, wantErr: "invalid duration"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &api.PodAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "req"},
+				Spec:       api.PodAccessRequestSpec{Duration: tt.duration},
+			}
+			got, capped, err := extendedDuration(req, tmpl, tt.by)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want || capped != tt.wantCapped {
+				t.Errorf("got %s (capped %t), want %s (capped %t)", got, capped, tt.want, tt.wantCapped)
+			}
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := map[time.Duration]string{
+		2 * time.Hour:                    "2h",
+		90 * time.Minute:                 "90m",
+		90*time.Minute + 30*time.Second:  "5430s",
+		90*time.Second + time.Nanosecond: "90s",
+	}
+	for d, want := range tests {
+		if got := formatDuration(d); got != want {
+			t.Errorf("formatDuration(%s) = %s, want %s", d, got, want)
+		}
+	}
+}
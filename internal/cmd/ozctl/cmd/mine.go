@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/printers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+var mineExample = `
+List your live Access Requests in the current namespace:
+$ ozctl mine
+KIND                NAME          TEMPLATE    READY   REMAINING   ACCESS
+ExecAccessRequest   user-abc12    app-shell   true    42m10s      kubectl exec -ti -n default app-7d9f -- /bin/sh
+PodAccessRequest    user-def34    toolbox     false   1h0m0s
+`
+
+var mineCmd = &cobra.Command{
+	Use:     "mine",
+	Short:   "List your live Access Requests, with their remaining time and access commands",
+	Example: mineExample,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		cl, namespace := getKubeClient()
+
+		user, err := whoAmI(cmd.Context(), cl)
+		if err != nil {
+			fmt.Printf(logError("Error - %s\n"), err)
+			os.Exit(1)
+		}
+
+		rows, err := listMyRequests(cmd.Context(), cl, namespace, user.Username, time.Now())
+		if err != nil {
+			fmt.Printf(logError("Error - %s\n"), err)
+			os.Exit(1)
+		}
+		if len(rows) == 0 {
+			cmd.Printf(logNotice("No live Access Requests for %s found in namespace %s\n"), user.Username, namespace)
+			return
+		}
+
+		w := printers.GetNewTabWriter(cmd.OutOrStdout())
+		fmt.Fprintln(w, "KIND\tNAME\tTEMPLATE\tREADY\tREMAINING\tACCESS")
+		for _, r := range rows {
+			remaining := "<unknown>"
+			if r.Remaining != nil {
+				remaining = r.Remaining.String()
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\t%s\n",
+				r.Kind, r.Name, r.Template, r.Ready, remaining, r.Access)
+		}
+		_ = w.Flush()
+	},
+}
+
+// requestRow describes one of the user's live Access Requests.
+type requestRow struct {
+	Kind     string
+	Name     string
+	Template string
+	Ready    bool
+
+	// Remaining is the time until the request expires, or nil if the
+	// controller has not worked that out yet
+	Remaining *time.Duration
+
+	// Access holds the access instructions, on a single line
+	Access string
+}
+
+// listMyRequests returns the live Access Requests in the namespace that were
+// created by the user, sorted by the time they have left. Requests that have
+// expired, been revoked, or are being deleted are left out.
+func listMyRequests(
+	ctx context.Context,
+	cl client.Client,
+	namespace string,
+	username string,
+	now time.Time,
+) ([]requestRow, error) {
+	rows := []requestRow{}
+	for kind, newObj := range requestKinds {
+		items, err := listObjects(ctx, cl, namespace, newObj)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list %ss: %w", kind, err)
+		}
+		for _, item := range items {
+			req, ok := item.(api.IRequestResource)
+			if !ok {
+				continue
+			}
+			if requester := req.GetRequestedBy(); requester == nil || requester.Username != username {
+				continue
+			}
+			if req.GetDeletionTimestamp() != nil || req.IsRevoked() {
+				continue
+			}
+
+			status := req.GetStatus().(api.IRequestStatus)
+			row := requestRow{
+				Kind:     kind,
+				Name:     req.GetName(),
+				Template: req.GetTemplateName(),
+				Ready:    status.IsReady(),
+				Access:   strings.Join(strings.Fields(status.GetAccessMessage()), " "),
+			}
+			if expiresAt := status.GetExpiresAt(); expiresAt != nil {
+				remaining := expiresAt.Sub(now).Round(time.Second)
+				if remaining <= 0 {
+					continue
+				}
+				row.Remaining = &remaining
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	// Requests that are about to expire first, those we know nothing about last
+	sort.Slice(rows, func(i, j int) bool {
+		ri, rj := rows[i].Remaining, rows[j].Remaining
+		switch {
+		case ri != nil && rj != nil && *ri != *rj:
+			return *ri < *rj
+		case (ri == nil) != (rj == nil):
+			return rj == nil
+		}
+		return rows[i].Name < rows[j].Name
+	})
+	return rows, nil
+}
+
+func init() {
+	kubeConfigFlags.AddFlags(mineCmd.Flags())
+	rootCmd.AddCommand(mineCmd)
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+func TestListMyRequests(t *testing.T) {
+	// Timestamps only keep whole seconds once stored
+	now := time.Now().Truncate(time.Second)
+	mine := &api.RequesterInfo{Username: "jane"}
+	expiresIn := func(d time.Duration) api.CoreStatus {
+		expiresAt := metav1.NewTime(now.Add(d))
+		return api.CoreStatus{Ready: true, AccessMessage: "kubectl exec -ti\n  app -- /bin/sh", ExpiresAt: &expiresAt}
+	}
+
+	objs := []client.Object{
+		&api.ExecAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "later", Namespace: "ns"},
+			Spec:       api.ExecAccessRequestSpec{TemplateName: "app-shell", RequestedBy: mine},
+			Status:     api.ExecAccessRequestStatus{CoreStatus: expiresIn(time.Hour)},
+		},
+		&api.PodAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "sooner", Namespace: "ns"},
+			Spec:       api.PodAccessRequestSpec{TemplateName: "toolbox", RequestedBy: mine},
+			Status:     api.PodAccessRequestStatus{CoreStatus: expiresIn(10 * time.Minute)},
+		},
+		&api.LogAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "ns"},
+			Spec:       api.LogAccessRequestSpec{TemplateName: "logs", RequestedBy: mine},
+		},
+		&api.ExecAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "expired", Namespace: "ns"},
+			Spec:       api.ExecAccessRequestSpec{TemplateName: "app-shell", RequestedBy: mine},
+			Status:     api.ExecAccessRequestStatus{CoreStatus: expiresIn(-time.Minute)},
+		},
+		&api.ExecAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "revoked", Namespace: "ns"},
+			Spec:       api.ExecAccessRequestSpec{TemplateName: "app-shell", RequestedBy: mine, Revoked: true},
+			Status:     api.ExecAccessRequestStatus{CoreStatus: expiresIn(time.Hour)},
+		},
+		&api.ExecAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "someone-elses", Namespace: "ns"},
+			Spec: api.ExecAccessRequestSpec{
+				TemplateName: "app-shell",
+				RequestedBy:  &api.RequesterInfo{Username: "bob"},
+			},
+			Status: api.ExecAccessRequestStatus{CoreStatus: expiresIn(time.Hour)},
+		},
+	}
+	cl := fake.NewClientBuilder().WithScheme(newWatchTestScheme(t)).WithObjects(objs...).Build()
+
+	rows, err := listMyRequests(context.Background(), cl, "ns", "jane", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	names := []string{}
+	for _, r := range rows {
+		names = append(names, r.Name)
+	}
+	if len(rows) != 3 || names[0] != "sooner" || names[1] != "later" || names[2] != "new" {
+		t.Fatalf("expected [sooner later new], got %v", names)
+	}
+	if *rows[0].Remaining != 10*time.Minute || rows[0].Kind != "PodAccessRequest" {
+		t.Errorf("unexpected row: %+v", rows[0])
+	}
+	if rows[1].Access != "kubectl exec -ti app -- /bin/sh" {
+		t.Errorf("expected the access message on one line, got %q", rows[1].Access)
+	}
+	if rows[2].Remaining != nil {
+		t.Errorf("expected no remaining time before the controller sets it, got %s", rows[2].Remaining)
+	}
+}
@@ -0,0 +1,285 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	rolloutsv1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/builders/podaccessbuilder"
+)
+
+// The name and UID of the (pretend) PodAccessRequest that is rendered. They
+// only show up in the name of the rendered Pod.
+const (
+	renderRequestName = "preview"
+	renderRequestUID  = types.UID("00000000-0000-0000-0000-000000000000")
+)
+
+var (
+	// Holder for the value of the --template flag
+	renderTemplateFile string
+
+	// Holder for the value of the --target flag
+	renderTargetFile string
+
+	// renderOutputFormat holds the output format for the render command (yaml, json)
+	renderOutputFormat = OutputFormatYAML
+)
+
+var renderExample = `
+Render the Pod that a PodAccessTemplate launches from a Deployment:
+$ ozctl render --template pod_access_template.yaml --target deployment.yaml
+
+A PodAccessTemplate with a Spec.podSpec does not need a --target:
+$ ozctl render --template toolbox.yaml
+
+Check that the template still fits within its own resource limits:
+$ ozctl render --template pod_access_template.yaml --target deployment.yaml --cpu 2 --memory 4Gi
+`
+
+var renderCmd = &cobra.Command{
+	Use:   "render --template <file> [--target <file>]",
+	Short: "Render the Pod that a PodAccessTemplate launches, without a cluster",
+	Long: `Render the Pod that a PodAccessTemplate launches, without a cluster.
+
+The PodSpec is extracted from the controller in the --target manifest (a
+Deployment, DaemonSet, StatefulSet or Argo Rollout) and run through the
+Spec.controllerTargetMutationConfig of the template, exactly as the Oz
+controller would do it. The final Pod manifest is printed, followed by the
+rendered Spec.accessConfig.accessCommand.
+
+Both files may contain several YAML documents. The namespaces in the --target
+file are ignored - the controller is looked up in the namespace of the
+template.
+
+Any failure - eg. an invalid template, a missing controller or a JSON patch
+that does not apply - exits with a non-zero exit code, so this can be used to
+check template changes in CI.`,
+	Example: renderExample,
+	Args:    cobra.NoArgs,
+
+	// Static validation of the inputs - cannot be used to set state in the Run function.
+	PreRunE: func(_ *cobra.Command, _ []string) error {
+		if _, err := podResources(); err != nil {
+			return err
+		}
+		return nil
+	},
+
+	Run: func(cmd *cobra.Command, _ []string) {
+		resources, _ := podResources()
+		result, err := renderPodAccessTemplate(
+			cmd.Context(), renderTemplateFile, renderTargetFile, resources,
+		)
+		if err != nil {
+			fmt.Printf(logError("Error - %s\n"), err)
+			os.Exit(1)
+		}
+		if err := writeRenderResult(cmd.OutOrStdout(), result, renderOutputFormat); err != nil {
+			fmt.Printf(logError("Error - %s\n"), err)
+			os.Exit(1)
+		}
+	},
+}
+
+// renderResult is the outcome of rendering a PodAccessTemplate.
+type renderResult struct {
+	Pod           *corev1.Pod `json:"pod"`
+	AccessCommand string      `json:"accessCommand"`
+}
+
+// newRenderScheme returns a scheme that knows about every kind of controller
+// a PodAccessTemplate can target, as well as our own resources.
+func newRenderScheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(s))
+	utilruntime.Must(rolloutsv1alpha1.AddToScheme(s))
+	utilruntime.Must(api.AddToScheme(s))
+	return s
+}
+
+// renderPodAccessTemplate renders the PodAccessTemplate in templatePath,
+// against the controllers in targetPath (which may be empty for templates with
+// a Spec.podSpec).
+func renderPodAccessTemplate(
+	ctx context.Context,
+	templatePath string,
+	targetPath string,
+	resources *corev1.ResourceRequirements,
+) (renderResult, error) {
+	scheme := newRenderScheme()
+
+	tmpl, err := readPodAccessTemplate(scheme, templatePath)
+	if err != nil {
+		return renderResult{}, err
+	}
+	if err := tmpl.Validate(); err != nil {
+		return renderResult{}, fmt.Errorf("invalid PodAccessTemplate %s: %w", tmpl.Name, err)
+	}
+	if tmpl.Namespace == "" {
+		tmpl.Namespace = "default"
+	}
+
+	// The controller-runtime fake client stands in for the cluster - it only
+	// ever holds the objects from the --target file.
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	if ref := tmpl.GetTargetRef(); ref != nil && ref.Name != "" {
+		if targetPath == "" {
+			return renderResult{}, fmt.Errorf(
+				"PodAccessTemplate %s targets %s %s, supply its manifest with --target",
+				tmpl.Name, ref.Kind, ref.Name,
+			)
+		}
+		targets, err := readObjects(scheme, targetPath)
+		if err != nil {
+			return renderResult{}, err
+		}
+		for _, obj := range targets {
+			obj.SetNamespace(tmpl.Namespace)
+			builder = builder.WithObjects(obj)
+		}
+	}
+
+	req := &api.PodAccessRequest{}
+	req.Name = renderRequestName
+	req.Namespace = tmpl.Namespace
+	req.UID = renderRequestUID
+	req.Spec.TemplateName = tmpl.Name
+	req.Spec.Resources = resources
+
+	pod, accessCommand, err := podaccessbuilder.RenderPod(ctx, builder.Build(), req, tmpl)
+	if err != nil {
+		return renderResult{}, fmt.Errorf("unable to render PodAccessTemplate %s: %w", tmpl.Name, err)
+	}
+	pod.APIVersion = "v1"
+	pod.Kind = "Pod"
+	return renderResult{Pod: pod, AccessCommand: accessCommand}, nil
+}
+
+// readPodAccessTemplate returns the one PodAccessTemplate in the file.
+func readPodAccessTemplate(scheme *runtime.Scheme, path string) (*api.PodAccessTemplate, error) {
+	if path == "" {
+		return nil, errors.New("--template is required")
+	}
+	objs, err := readObjects(scheme, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *api.PodAccessTemplate
+	for _, obj := range objs {
+		if tmpl, ok := obj.(*api.PodAccessTemplate); ok {
+			if found != nil {
+				return nil, fmt.Errorf("%s contains more than one PodAccessTemplate", path)
+			}
+			found = tmpl
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%s does not contain a PodAccessTemplate", path)
+	}
+	return found, nil
+}
+
+// readObjects decodes every YAML (or JSON) document in the file. Fields that
+// are not part of the schema of a kind are reported as errors, to catch
+// typos, but kinds the scheme does not know about are skipped.
+func readObjects(scheme *runtime.Scheme, path string) ([]client.Object, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	decoder := serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDeserializer()
+	reader := utilyaml.NewYAMLOrJSONDecoder(bufio.NewReader(f), 4096)
+
+	objs := []client.Object{}
+	for {
+		raw := runtime.RawExtension{}
+		if err := reader.Decode(&raw); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+		}
+		if len(raw.Raw) == 0 || string(raw.Raw) == "null" {
+			continue
+		}
+
+		obj, _, err := decoder.Decode(raw.Raw, nil, nil)
+		if runtime.IsNotRegisteredError(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode %s: %w", path, err)
+		}
+		if cObj, ok := obj.(client.Object); ok {
+			objs = append(objs, cObj)
+		}
+	}
+	return objs, nil
+}
+
+// writeRenderResult writes the result in the requested format. The YAML
+// format is the Pod manifest, with the access command appended as a comment,
+// so the output remains a valid manifest.
+func writeRenderResult(w io.Writer, result renderResult, format string) error {
+	if format == OutputFormatJSON {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+
+	data, err := yaml.Marshal(result.Pod)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	comment := "# Access command:\n"
+	for _, line := range strings.Split(strings.TrimRight(result.AccessCommand, "\n"), "\n") {
+		comment += fmt.Sprintf("#   %s\n", line)
+	}
+	_, err = io.WriteString(w, comment)
+	return err
+}
+
+func init() {
+	renderCmd.Flags().
+		StringVarP(&renderTemplateFile, "template", "t", "", "Path to the file containing the PodAccessTemplate")
+	renderCmd.Flags().
+		StringVar(&renderTargetFile, "target", "", "Path to the file containing the controller targeted by the template")
+	renderCmd.Flags().
+		StringVar(&cpu, "cpu", "", "CPU to request for the Pod, eg. 2 or 500m. Must not exceed the maxCpu of the template.")
+	renderCmd.Flags().
+		StringVar(&memory, "memory", "", "Memory to request for the Pod, eg. 4Gi. Must not exceed the maxMemory of the template.")
+	renderCmd.Flags().
+		StringVar(&storage, "storage", "", "Ephemeral storage to request for the Pod, eg. 10Gi. Must not exceed the maxStorage of the template.")
+	renderCmd.Flags().
+		StringVarP(&renderOutputFormat, "output", "o", OutputFormatYAML, "Output format: yaml or json")
+
+	rootCmd.AddCommand(renderCmd)
+}
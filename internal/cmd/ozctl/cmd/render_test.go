@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+const renderTestDeployment = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: app
+spec:
+  ports:
+  - port: 80
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  namespace: somewhere-else
+spec:
+  selector:
+    matchLabels:
+      app: app
+  template:
+    metadata:
+      labels:
+        app: app
+    spec:
+      containers:
+      - name: app
+        image: app:latest
+        command: [/app/server]
+`
+
+const renderTestTemplate = `
+apiVersion: crds.wizardofoz.co/v1alpha1
+kind: PodAccessTemplate
+metadata:
+  name: app-debug
+  namespace: payments
+spec:
+  accessConfig:
+    allowedGroups: [devs]
+    defaultDuration: 1h
+    maxDuration: 2h
+    accessCommand: kubectl exec -ti -n {{ .Metadata.Namespace }} {{ .Metadata.Name }} -- /bin/sh
+  controllerTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: app
+  controllerTargetMutationConfig:
+    command: [/bin/sleep, "3600"]
+`
+
+func writeRenderFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRenderPodAccessTemplate(t *testing.T) {
+	ctx := context.Background()
+	tmplPath := writeRenderFile(t, "template.yaml", renderTestTemplate)
+	targetPath := writeRenderFile(t, "deployment.yaml", renderTestDeployment)
+
+	result, err := renderPodAccessTemplate(ctx, tmplPath, targetPath, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pod := result.Pod
+	if pod.Namespace != "payments" || pod.Name != "preview-00000000" {
+		t.Errorf("unexpected Pod name: %s/%s", pod.Namespace, pod.Name)
+	}
+	if got := pod.Spec.Containers[0].Command; len(got) != 2 || got[0] != "/bin/sleep" {
+		t.Errorf("expected the mutation config to be applied, got command %v", got)
+	}
+	if result.AccessCommand != "kubectl exec -ti -n payments preview-00000000 -- /bin/sh" {
+		t.Errorf("unexpected access command: %q", result.AccessCommand)
+	}
+
+	// The target is required for templates that have a controllerTargetRef
+	if _, err := renderPodAccessTemplate(ctx, tmplPath, "", nil); err == nil ||
+		!strings.Contains(err.Error(), "--target") {
+		t.Errorf("expected a missing --target error, got %v", err)
+	}
+
+	// Failures to patch the PodSpec are reported
+	badPatch := writeRenderFile(t, "bad.yaml", renderTestTemplate+`
+    patchSpecOperations:
+    - op: replace
+      path: /spec/nope/0/name
+      value: x
+`)
+	if _, err := renderPodAccessTemplate(ctx, badPatch, targetPath, nil); err == nil {
+		t.Error("expected an error for a patch that does not apply")
+	}
+
+	// Typos in the template are caught
+	typo := writeRenderFile(t, "typo.yaml", strings.Replace(renderTestTemplate, "maxDuration", "maxDurration", 1))
+	if _, err := renderPodAccessTemplate(ctx, typo, targetPath, nil); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+}
+
+func TestRenderPodAccessTemplatePodSpec(t *testing.T) {
+	tmplPath := writeRenderFile(t, "template.yaml", `
+apiVersion: crds.wizardofoz.co/v1alpha1
+kind: PodAccessTemplate
+metadata:
+  name: toolbox
+spec:
+  accessConfig:
+    allowedGroups: [devs]
+    defaultDuration: 1h
+    maxDuration: 1h
+    accessCommand: kubectl exec -ti {{ .Metadata.Name }} -- /bin/sh
+  podSpec:
+    containers:
+    - name: toolbox
+      image: busybox
+`)
+
+	result, err := renderPodAccessTemplate(context.Background(), tmplPath, "", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Pod.Namespace != "default" || result.Pod.Labels[api.LabelTemplateName] == "" {
+		t.Errorf("unexpected Pod metadata: %+v", result.Pod.ObjectMeta)
+	}
+
+	var out bytes.Buffer
+	if err := writeRenderResult(&out, result, OutputFormatYAML); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "kind: Pod\n") ||
+		!strings.HasSuffix(out.String(), "# Access command:\n#   kubectl exec -ti preview-00000000 -- /bin/sh\n") {
+		t.Errorf("unexpected output: %s", out.String())
+	}
+}
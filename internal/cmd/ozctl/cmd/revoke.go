@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+// Holder for the value of the --kind flag
+var revokeRequestKind string
+
+var revokeExample = `
+Revoke an Access Request in the current namespace before it expires:
+$ ozctl revoke user-abc12
+
+Anyone allowed to update the Access Request may revoke it - not just the
+user that created it. The revoking user is recorded in spec.revokedBy, and
+the request (along with any access resources it created) is deleted shortly
+afterwards.
+`
+
+var revokeCmd = &cobra.Command{
+	Use:     "revoke <Access Request Name>",
+	Short:   "Revoke a live Access Request before it expires",
+	Example: revokeExample,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		revokeAccessRequest(cmd, args[0])
+	},
+}
+
+func revokeAccessRequest(cmd *cobra.Command, requestName string) {
+	cl, namespace := getKubeClient()
+
+	kind, err := findAccessRequestKind(cmd.Context(), cl, namespace, requestName, revokeRequestKind)
+	if err != nil {
+		fmt.Printf(logError("Error - %s\n"), err)
+		os.Exit(1)
+	}
+
+	req := requestKinds[kind]().(api.IRequestResource)
+	if err := cl.Get(cmd.Context(), types.NamespacedName{Name: requestName, Namespace: namespace}, req); err != nil {
+		fmt.Printf(logError("Error - %s\n"), err)
+		os.Exit(1)
+	}
+
+	if err := revokeRequest(cmd.Context(), cl, req); err != nil {
+		fmt.Printf(logError("Error - Revoking %s %s failed:\n  %s\n"), kind, requestName, err)
+		os.Exit(1)
+	}
+
+	if revoker := req.GetRevokedBy(); revoker != nil {
+		cmd.Printf(logSuccess("%s %s revoked by %s\n"), kind, requestName, revoker.Username)
+	} else {
+		cmd.Printf(logSuccess("%s %s revoked\n"), kind, requestName)
+	}
+}
+
+// revokeRequest sets the Spec.revoked field of the request. The request is
+// updated in place with the response from the API, which includes the
+// Spec.revokedBy field recorded by the admission webhook.
+func revokeRequest(ctx context.Context, cl client.Client, req api.IRequestResource) error {
+	if req.IsRevoked() {
+		revokedBy := "unknown"
+		if revoker := req.GetRevokedBy(); revoker != nil {
+			revokedBy = revoker.Username
+		}
+		return fmt.Errorf("%s has already been revoked by %s", req.GetName(), revokedBy)
+	}
+
+	patch := []byte(`{"spec":{"revoked":true}}`)
+	return cl.Patch(ctx, req, client.RawPatch(types.MergePatchType, patch))
+}
+
+func init() {
+	revokeCmd.Flags().
+		StringVarP(&revokeRequestKind, "kind", "k", "", "Kind of the Access Request (eg. ExecAccessRequest), only required if the name is ambiguous")
+	kubeConfigFlags.AddFlags(revokeCmd.Flags())
+	rootCmd.AddCommand(revokeCmd)
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+func TestRevokeRequest(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := api.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	live := &api.ExecAccessRequest{ObjectMeta: metav1.ObjectMeta{Name: "live", Namespace: "ns"}}
+	revoked := &api.ExecAccessRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "revoked", Namespace: "ns"},
+		Spec: api.ExecAccessRequestSpec{
+			Revoked:   true,
+			RevokedBy: &api.RequesterInfo{Username: "security"},
+		},
+	}
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(live, revoked).Build()
+
+	// A live request is revoked
+	if err := revokeRequest(context.Background(), cl, live.DeepCopy()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := &api.ExecAccessRequest{}
+	if err := cl.Get(context.Background(), types.NamespacedName{Name: "live", Namespace: "ns"}, got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Spec.Revoked {
+		t.Errorf("expected spec.revoked to be set")
+	}
+
+	// An already-revoked request names the original revoker
+	err := revokeRequest(context.Background(), cl, revoked.DeepCopy())
+	if err == nil || !strings.Contains(err.Error(), "already been revoked by security") {
+		t.Errorf("expected an already revoked error, got %v", err)
+	}
+}
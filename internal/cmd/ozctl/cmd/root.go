@@ -2,7 +2,6 @@
 package cmd
 
 import (
-	"errors"
 	"fmt"
 	"os"
 
@@ -11,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	api "github.com/diranged/oz/internal/api/v1alpha1"
 )
@@ -18,10 +18,10 @@ import (
 // Variables filled out during the initial `Execute()` step. These are used throughout the various
 // commands create within this CLI tool.
 var (
-	// usernamEnv stores the value of the `USER` environment variable. This is
-	// used for naming the Access Requests in somewhat friendly ways - but has
-	// nothing to do with whether or not access is granted via RBAC.
-
+	// usernameEnv stores the value of the `USER` environment variable. This is
+	// only used for naming the Access Requests when the Kubernetes username of
+	// the caller cannot be determined - and has nothing to do with whether or
+	// not access is granted via RBAC.
 	usernameEnv = os.Getenv("USER")
 
 	// kubeConfigFlags are generated once and stored here for reference by the sub commands.
@@ -45,7 +45,48 @@ Access Requests are short-lived temporary permissions requests to manage
 existing resources, or requests for dedicated short term resources (like a
 temporary development Pod).
 
+Configuration:
+
+  Defaults for the most common flags can be set in ~/.config/oz/config.yaml
+  (or $XDG_CONFIG_HOME/oz/config.yaml), along with aliases for the templates
+  you use most:
+
+    namespace: payments
+    duration: 2h
+    wait: 5m
+    requestName: alice
+    output: text
+    aliases:
+      prod-shell:
+        kind: ExecAccessTemplate
+        template: payments-api-shell
+        namespace: payments-prod
+        duration: 30m
+
+  An alias can be used wherever a template name is expected, eg.
+  "ozctl exec prod-shell". Its settings apply to that command only.
+
+  $OZ_CONFIG overrides the location of the config file. It can list several
+  files, separated by ":" - eg. a personal file followed by one shipped by your
+  team. The first file to set a value, or define an alias, wins.
+
+  Each setting can also be supplied by an environment variable: $OZ_NAMESPACE,
+  $OZ_DURATION, $OZ_WAIT, $OZ_REQUEST_NAME and $OZ_OUTPUT.
+
+  Precedence, highest first:
+    1. Command line flags (eg. -n, -D, -w, -N, -o)
+    2. The settings of the alias being used
+    3. Environment variables
+    4. The config file(s)
+    5. The built-in defaults of each command
+
 `,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := applyConfig(cmd, args, config); err != nil {
+			return err
+		}
+		return defaultRequestNamePrefix(cmd)
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -61,12 +102,6 @@ func Execute() {
 		Flags:    cc.Bold,
 	})
 
-	// Sanity check
-	if err := verifyUsernameSet(); err != nil {
-		fmt.Println(err)
-		os.Exit(1)
-	}
-
 	// Populate our scopedScheme
 	scopedScheme, _ = api.SchemeBuilder.Build()
 
@@ -83,10 +118,23 @@ func Execute() {
 	}
 }
 
-func verifyUsernameSet() error {
-	if usernameEnv == "" {
-		return errors.New("this CLI tool requires that the $USER environment be set to something")
+// defaultRequestNamePrefix sets the --request-name of the command from the
+// Kubernetes username of the caller, unless it was supplied already.
+func defaultRequestNamePrefix(cmd *cobra.Command) error {
+	if cmd.Flags().Lookup("request-name") == nil || requestNamePrefix != "" {
+		return nil
+	}
+
+	var cl client.Client
+	if restCfg, err := kubeConfigFlags.ToRESTConfig(); err == nil {
+		cl, _ = client.New(restCfg, client.Options{})
 	}
+
+	prefix, err := resolveRequestNamePrefix(cmd.Context(), cl, usernameEnv)
+	if err != nil {
+		return err
+	}
+	requestNamePrefix = prefix
 	return nil
 }
 
@@ -107,4 +155,12 @@ func init() {
 	cobra.OnInitialize(initConfig)
 }
 
-func initConfig() {}
+// initConfig loads the config file(s), if there are any.
+func initConfig() {
+	cfg, err := loadConfig(configPaths())
+	if err != nil {
+		fmt.Printf(logError("Error - %s\n"), err)
+		os.Exit(1)
+	}
+	config = cfg
+}
@@ -0,0 +1,275 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+var (
+	// Holder for the value of the --container flag
+	runContainer string
+
+	// Holder for the values of the --wait and --output flags
+	runOpts requestOptions
+)
+
+var runExample = `
+Run a one-off command in a new Pod from a PodAccessTemplate, and exit with its
+exit code:
+$ ozctl run <existing template> -- ./manage.py migrate
+
+Get the result as a JSON document instead, eg. for use in CI:
+$ ozctl run <existing template> --output json -- redis-cli FLUSHALL
+
+The PodAccessRequest is always deleted once the command has finished (or
+failed to start), so its Pod is cleaned up straight away.
+`
+
+var runCmd = &cobra.Command{
+	Use:         "run <PodAccessTemplate Name> -- <command...>",
+	Annotations: map[string]string{templateKindAnnotation: "PodAccessTemplate"},
+	Short:       "Run a single command in a new PodAccessRequest Pod",
+	Example:     runExample,
+	Args:        cobra.MinimumNArgs(2),
+
+	// Static validation of the inputs - cannot be used to set state in the Run function.
+	PreRunE: func(_ *cobra.Command, _ []string) error {
+		// Request name prefix must start with letters a-z, can contain dashes, and must end in a
+		// letter or number.
+		re, err := regexp.Compile(`^[a-z][a-z0-9-][a-z0-9]+`)
+		if err != nil {
+			return err
+		}
+		if !re.MatchString(requestNamePrefix) {
+			return fmt.Errorf("invalid request name prefix: %s", requestNamePrefix)
+		}
+
+		// Verify the waitTime syntax
+		_, err = time.ParseDuration(runOpts.waitTime)
+		if err != nil {
+			return fmt.Errorf("invalid time supplied: %s", runOpts.waitTime)
+		}
+
+		// Verify the resource quantities
+		if _, err := podResources(); err != nil {
+			return err
+		}
+
+		if runOpts.outputFormat != OutputFormatText && runOpts.outputFormat != OutputFormatJSON {
+			return fmt.Errorf("invalid output format %q, must be text or json", runOpts.outputFormat)
+		}
+		return nil
+	},
+
+	Run: func(cmd *cobra.Command, args []string) {
+		templateName := args[0]
+		command := args[1:]
+
+		// Make sure the request is still cleaned up if we are interrupted,
+		// eg. by a cancelled CI job.
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		cl, namespace := getKubeClient()
+
+		req := &api.PodAccessRequest{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "PodAccessRequest",
+				APIVersion: api.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: fmt.Sprintf("%s-", requestNamePrefix),
+				Namespace:    namespace,
+			},
+			Spec: api.PodAccessRequestSpec{
+				TemplateName: templateName,
+				Duration:     duration,
+			},
+		}
+		req.Spec.Resources, _ = podResources()
+
+		result := runOnce(ctx, cmd, cl, req, command, runOpts)
+
+		if runOpts.outputFormat == OutputFormatJSON {
+			if err := writeRunResult(cmd.OutOrStdout(), result); err != nil {
+				fmt.Printf(logError("Error marshalling to JSON: %s\n"), err)
+				os.Exit(1)
+			}
+		} else {
+			if result.Error != "" {
+				fmt.Printf(logError("Error - %s\n"), result.Error)
+			}
+			if result.Deleted {
+				cmd.Printf(logNotice("%s deleted\n"), req.GetName())
+			}
+		}
+		os.Exit(result.ExitCode)
+	},
+}
+
+// runResult is the outcome of "ozctl run", and is printed as-is with
+// --output json.
+type runResult struct {
+	Request   string   `json:"request"`
+	Namespace string   `json:"namespace"`
+	Pod       string   `json:"pod,omitempty"`
+	Container string   `json:"container,omitempty"`
+	Command   []string `json:"command"`
+
+	// ExitCode is the exit code of the command, or 1 if it could not be run
+	ExitCode int    `json:"exitCode"`
+	Stdout   string `json:"stdout"`
+	Stderr   string `json:"stderr"`
+
+	// Error explains why the command could not be run, or the request could
+	// not be deleted afterwards
+	Error string `json:"error,omitempty"`
+
+	// Deleted is true once the PodAccessRequest has been deleted
+	Deleted bool `json:"deleted"`
+}
+
+// runOnce creates the PodAccessRequest, runs the command in its Pod and then
+// deletes the request again. Every failure is recorded in the result rather
+// than exiting, so that the result can always be written out.
+func runOnce(
+	ctx context.Context,
+	cmd *cobra.Command,
+	cl client.Client,
+	req *api.PodAccessRequest,
+	command []string,
+	opts requestOptions,
+) runResult {
+	result := runResult{
+		Namespace: req.GetNamespace(),
+		Command:   command,
+		ExitCode:  1,
+	}
+
+	if err := lookupTemplate(cmd, cl, req, opts); err != nil {
+		result.Error = fmt.Sprintf("invalid template %s: %s", req.GetTemplateName(), err)
+		return result
+	}
+	if err := submitAccessRequest(cmd, cl, req, opts); err != nil {
+		result.Error = fmt.Sprintf("creating PodAccessRequest failed: %s", err)
+		return result
+	}
+	result.Request = req.GetName()
+
+	runInPodAccessRequest(ctx, cmd, cl, req, &result, opts)
+
+	// Always clean up. The context may have been cancelled already, so
+	// this must not use it.
+	if err := cl.Delete(context.Background(), req); client.IgnoreNotFound(err) != nil {
+		deleteErr := fmt.Sprintf("deleting %s failed: %s", req.GetName(), err)
+		if result.Error != "" {
+			deleteErr = fmt.Sprintf("%s; %s", result.Error, deleteErr)
+		}
+		result.Error = deleteErr
+	} else {
+		result.Deleted = true
+	}
+	return result
+}
+
+// runInPodAccessRequest waits for the PodAccessRequest to be ready, and then
+// runs the command in its Pod, recording the outcome in the result. With
+// --output json, the output of the command is captured in the result rather
+// than streamed.
+func runInPodAccessRequest(
+	ctx context.Context,
+	cmd *cobra.Command,
+	cl client.Client,
+	req *api.PodAccessRequest,
+	result *runResult,
+	opts requestOptions,
+) {
+	if err := awaitAccessRequest(cmd, req, opts); err != nil {
+		result.Error = err.Error()
+		if opts.outputFormat == OutputFormatText {
+			cmd.Println()
+			printConditions(cmd, req)
+		}
+		return
+	}
+
+	result.Pod = req.Status.PodName
+	result.Container = podContainer(ctx, cl, req.GetNamespace(), req.Status.PodName, runContainer)
+
+	var stdout, stderr bytes.Buffer
+	podExec := execOptions{
+		Namespace: req.GetNamespace(),
+		PodName:   result.Pod,
+		Container: result.Container,
+		Command:   result.Command,
+		Stdout:    os.Stdout,
+		Stderr:    os.Stderr,
+	}
+	if opts.outputFormat == OutputFormatJSON {
+		podExec.Stdout, podExec.Stderr = &stdout, &stderr
+	} else {
+		cmd.Printf(logNotice("\nRunning %s in %s...\n"), strings.Join(result.Command, " "), result.Pod)
+	}
+
+	err := execInPod(ctx, podExec)
+	result.Stdout, result.Stderr = stdout.String(), stderr.String()
+	if code, ok := remoteExitCode(err); ok {
+		result.ExitCode = code
+	} else if err != nil {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			err = fmt.Errorf("interrupted: %w", err)
+		}
+		result.Error = err.Error()
+	} else {
+		result.ExitCode = 0
+	}
+}
+
+// writeRunResult writes the result as an indented JSON document.
+func writeRunResult(w io.Writer, result runResult) error {
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+func init() {
+	runCmd.Flags().
+		StringVarP(&duration, "duration", "D", "", "Duration for the access request to be valid. Valid time units are: ns, us, ms, s, m, h.")
+	runCmd.Flags().
+		StringVarP(&runOpts.waitTime, "wait", "w", "5m", "Duration to wait for the access request to be fully ready. Valid time units are: ns, us, ms, s, m, h.")
+	runCmd.Flags().
+		StringVarP(&requestNamePrefix, "request-name", "N", "", "Prefix name to use when creating the `PodAccessRequest` objects. Defaults to your Kubernetes username.")
+	runCmd.Flags().
+		StringVar(&cpu, "cpu", "", "CPU to request for the Pod, eg. 2 or 500m. Must not exceed the maxCpu of the template.")
+	runCmd.Flags().
+		StringVar(&memory, "memory", "", "Memory to request for the Pod, eg. 4Gi. Must not exceed the maxMemory of the template.")
+	runCmd.Flags().
+		StringVar(&storage, "storage", "", "Ephemeral storage to request for the Pod, eg. 10Gi. Must not exceed the maxStorage of the template.")
+	runCmd.Flags().
+		StringVarP(&runContainer, "container", "c", "", "Container to run the command in, defaults to the default container of the Pod")
+	runCmd.Flags().
+		StringVarP(&runOpts.outputFormat, "output", "o", OutputFormatText, "Output format: json or text")
+
+	kubeConfigFlags.AddFlags(runCmd.Flags())
+
+	rootCmd.AddCommand(runCmd)
+}
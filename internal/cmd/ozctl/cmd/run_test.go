@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+func TestWriteRunResult(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeRunResult(&buf, runResult{
+		Request:   "user-abc12",
+		Namespace: "ns",
+		Pod:       "user-abc12-1a2b3c4d",
+		Command:   []string{"echo", "hi"},
+		ExitCode:  0,
+		Stdout:    "hi\n",
+		Deleted:   true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := map[string]any{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, buf.String())
+	}
+	if got["exitCode"] != float64(0) || got["stdout"] != "hi\n" || got["deleted"] != true {
+		t.Errorf("unexpected result: %s", buf.String())
+	}
+	if _, ok := got["error"]; ok {
+		t.Errorf("expected no error field, got %s", buf.String())
+	}
+}
+
+func TestRunOnceRecordsFailures(t *testing.T) {
+	tmpl := &api.PodAccessTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "tmpl", Namespace: "ns"},
+	}
+	newRequest := func(templateName string) *api.PodAccessRequest {
+		return &api.PodAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "user-", Namespace: "ns"},
+			Spec:       api.PodAccessRequestSpec{TemplateName: templateName},
+		}
+	}
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+	command := []string{"echo", "hi"}
+
+	tests := []struct {
+		name      string
+		cl        client.Client
+		req       *api.PodAccessRequest
+		wantError string
+	}{
+		{
+			name:      "missing template",
+			cl:        fake.NewClientBuilder().WithScheme(newWatchTestScheme(t)).Build(),
+			req:       newRequest("missing"),
+			wantError: "invalid template missing",
+		},
+		{
+			name: "request creation fails",
+			cl: fake.NewClientBuilder().
+				WithScheme(newWatchTestScheme(t)).
+				WithObjects(tmpl).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(context.Context, client.WithWatch, client.Object, ...client.CreateOption) error {
+						return errors.New("denied by the webhook")
+					},
+				}).
+				Build(),
+			req:       newRequest("tmpl"),
+			wantError: "creating PodAccessRequest failed: denied by the webhook",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := runOnce(context.Background(), cmd, tt.cl, tt.req, command, requestOptions{outputFormat: OutputFormatJSON})
+			if !strings.Contains(result.Error, tt.wantError) {
+				t.Errorf("expected error %q, got %q", tt.wantError, result.Error)
+			}
+			if result.ExitCode != 1 || result.Deleted || result.Request != "" {
+				t.Errorf("unexpected result: %+v", result)
+			}
+
+			// The result must still be written out as JSON.
+			var buf bytes.Buffer
+			if err := writeRunResult(&buf, result); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := map[string]any{}
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("output is not valid JSON: %v\n%s", err, buf.String())
+			}
+			if got["error"] != result.Error {
+				t.Errorf("expected the error in the output, got %s", buf.String())
+			}
+		})
+	}
+}
+
+func TestRequestOptionsDefaults(t *testing.T) {
+	// Each command binds its own --wait flag, so registering one command
+	// must not change the default of another.
+	tests := []struct {
+		name string
+		opts requestOptions
+		want string
+	}{
+		{name: "run", opts: runOpts, want: "5m"},
+		{name: "exec", opts: execOpts, want: "1m"},
+		{name: "create PodAccessRequest", opts: createPodAccessRequestOpts, want: "5m"},
+		{name: "create ExecAccessRequest", opts: createExecAccessRequestOpts, want: "1m"},
+	}
+	for _, tt := range tests {
+		if tt.opts.waitTime != tt.want {
+			t.Errorf("%s: expected a --wait default of %s, got %s", tt.name, tt.want, tt.opts.waitTime)
+		}
+		if tt.opts.outputFormat != OutputFormatText {
+			t.Errorf("%s: expected a text output by default, got %s", tt.name, tt.opts.outputFormat)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/cli-runtime/pkg/printers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+// templateKinds maps each Access Template kind to a function returning a new,
+// empty object of that kind.
+var templateKinds = map[string]func() client.Object{
+	"EphemeralContainerAccessTemplate": func() client.Object { return &api.EphemeralContainerAccessTemplate{} },
+	"ExecAccessTemplate":               func() client.Object { return &api.ExecAccessTemplate{} },
+	"LogAccessTemplate":                func() client.Object { return &api.LogAccessTemplate{} },
+	"PodAccessTemplate":                func() client.Object { return &api.PodAccessTemplate{} },
+	"PortForwardAccessTemplate":        func() client.Object { return &api.PortForwardAccessTemplate{} },
+}
+
+var templatesExample = `
+List the Access Templates in the current namespace, and whether you can use them:
+$ ozctl templates
+KIND                 NAME        TARGET                 DEFAULT   MAX   ALLOWED
+ExecAccessTemplate   app-shell   Deployment/app         1h        4h    true
+PodAccessTemplate    app-debug   Deployment/app         2h        8h    false
+PodAccessTemplate    toolbox     <podSpec>              1h        1h    true
+`
+
+var templatesCmd = &cobra.Command{
+	Use:     "templates",
+	Short:   "List the Access Templates in the namespace, and whether you can use them",
+	Example: templatesExample,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		cl, namespace := getKubeClient()
+
+		user, err := whoAmI(cmd.Context(), cl)
+		if err != nil {
+			fmt.Printf(logError("Error - %s\n"), err)
+			os.Exit(1)
+		}
+
+		rows, err := listTemplates(cmd.Context(), cl, namespace, user)
+		if err != nil {
+			fmt.Printf(logError("Error - %s\n"), err)
+			os.Exit(1)
+		}
+		if len(rows) == 0 {
+			cmd.Printf(logNotice("No Access Templates found in namespace %s\n"), namespace)
+			return
+		}
+
+		w := printers.GetNewTabWriter(cmd.OutOrStdout())
+		fmt.Fprintln(w, "KIND\tNAME\tTARGET\tDEFAULT\tMAX\tALLOWED")
+		for _, r := range rows {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%t\n",
+				r.Kind, r.Name, r.Target, r.DefaultDuration, r.MaxDuration, r.Allowed)
+		}
+		_ = w.Flush()
+	},
+}
+
+// templateRow describes an Access Template, from the point of view of the
+// user.
+type templateRow struct {
+	Kind            string
+	Name            string
+	Target          string
+	DefaultDuration string
+	MaxDuration     string
+
+	// Allowed is true if the user is a member of one of the AllowedGroups
+	Allowed bool
+}
+
+// listTemplates returns every Access Template in the namespace, sorted by
+// kind and name.
+func listTemplates(
+	ctx context.Context,
+	cl client.Client,
+	namespace string,
+	user authenticationv1.UserInfo,
+) ([]templateRow, error) {
+	rows := []templateRow{}
+	for kind, newObj := range templateKinds {
+		items, err := listObjects(ctx, cl, namespace, newObj)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list %ss: %w", kind, err)
+		}
+		for _, item := range items {
+			tmpl, ok := item.(api.ITemplateResource)
+			if !ok {
+				continue
+			}
+			rows = append(rows, templateRow{
+				Kind:            kind,
+				Name:            tmpl.GetName(),
+				Target:          templateTarget(tmpl),
+				DefaultDuration: tmpl.GetAccessConfig().DefaultDuration,
+				MaxDuration:     tmpl.GetAccessConfig().MaxDuration,
+				Allowed:         tmpl.GetAccessConfig().IsAllowed(user.Groups),
+			})
+		}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Kind != rows[j].Kind {
+			return rows[i].Kind < rows[j].Kind
+		}
+		return rows[i].Name < rows[j].Name
+	})
+	return rows, nil
+}
+
+// templateTarget describes what the template grants access to - the
+// controller it targets, or "<podSpec>" for a PodAccessTemplate that launches
+// standalone Pods.
+func templateTarget(tmpl api.ITemplateResource) string {
+	if ref := tmpl.GetTargetRef(); ref != nil && ref.Name != "" {
+		return fmt.Sprintf("%s/%s", ref.Kind, ref.Name)
+	}
+	if podTmpl, ok := tmpl.(*api.PodAccessTemplate); ok && podTmpl.Spec.PodSpec != nil {
+		return "<podSpec>"
+	}
+	return "<none>"
+}
+
+func init() {
+	kubeConfigFlags.AddFlags(templatesCmd.Flags())
+	rootCmd.AddCommand(templatesCmd)
+}
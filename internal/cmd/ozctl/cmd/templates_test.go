@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+func TestListTemplates(t *testing.T) {
+	accessConfig := func(groups ...string) api.AccessConfig {
+		return api.AccessConfig{AllowedGroups: groups, DefaultDuration: "1h", MaxDuration: "4h"}
+	}
+	objs := []client.Object{
+		&api.PodAccessTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "toolbox", Namespace: "ns"},
+			Spec: api.PodAccessTemplateSpec{
+				AccessConfig: accessConfig("devs"),
+				PodSpec:      &corev1.PodSpec{},
+			},
+		},
+		&api.ExecAccessTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-shell", Namespace: "ns"},
+			Spec: api.ExecAccessTemplateSpec{
+				AccessConfig: accessConfig("admins"),
+				ControllerTargetRef: &api.CrossVersionObjectReference{
+					APIVersion: "apps/v1", Kind: "Deployment", Name: "app",
+				},
+			},
+		},
+		&api.ExecAccessTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "elsewhere", Namespace: "other"},
+			Spec:       api.ExecAccessTemplateSpec{AccessConfig: accessConfig("devs")},
+		},
+	}
+	cl := fake.NewClientBuilder().WithScheme(newWatchTestScheme(t)).WithObjects(objs...).Build()
+
+	rows, err := listTemplates(context.Background(), cl, "ns", authenticationv1.UserInfo{Groups: []string{"devs"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []templateRow{
+		{Kind: "ExecAccessTemplate", Name: "app-shell", Target: "Deployment/app", DefaultDuration: "1h", MaxDuration: "4h"},
+		{Kind: "PodAccessTemplate", Name: "toolbox", Target: "<podSpec>", DefaultDuration: "1h", MaxDuration: "4h", Allowed: true},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("expected %d templates, got %+v", len(want), rows)
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Errorf("row %d: got %+v, want %+v", i, rows[i], want[i])
+		}
+	}
+}
@@ -38,3 +38,12 @@ func getKubeClient() (cl client.Client, ns string) {
 	cl = client.NewNamespacedClient(rawCl, ns)
 	return cl, ns
 }
+
+// getKubeWatchClient behaves like getKubeClient, but returns a client that can
+// also watch resources.
+func getKubeWatchClient() (cl client.WithWatch, ns string) {
+	kubeRestCfg, _ := kubeConfigFlags.ToRESTConfig()
+	cl, _ = client.NewWithWatch(kubeRestCfg, client.Options{})
+	ns = getDefaultKubeNamespace(kubeConfigFlags)
+	return cl, ns
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	api "github.com/diranged/oz/internal/api/v1alpha1"
 )
@@ -21,18 +22,24 @@ Error: - Invalid --template name flag passed in:
   %s
 `)
 
-func verifyTemplate(cmd *cobra.Command, req api.IRequestResource) {
-	client, _ := getKubeClient()
+func verifyTemplate(cmd *cobra.Command, req api.IRequestResource, opts requestOptions) {
+	cl, _ := getKubeClient()
+	if err := lookupTemplate(cmd, cl, req, opts); err != nil {
+		cmd.Printf(verifyingTemplateExistsFailedMsg, err)
+		os.Exit(1)
+	}
+}
 
-	if outputFormat == OutputFormatText {
+// lookupTemplate verifies that the template referenced by the request exists.
+// Unlike verifyTemplate, it returns the error rather than exiting - so callers
+// can report it in their own way.
+func lookupTemplate(cmd *cobra.Command, cl client.Client, req api.IRequestResource, opts requestOptions) error {
+	if opts.outputFormat == OutputFormatText {
 		cmd.Printf(accessRequestInitMsg, req.GetTemplateName(), requestNamePrefix)
 		cmd.Printf(verifyingTemplateExistsMsg, req.GetTemplateName(), req.GetNamespace())
 	}
 
 	// Verify the template exists
-	_, err := req.GetTemplate(cmd.Context(), client)
-	if err != nil {
-		cmd.Printf(verifyingTemplateExistsFailedMsg, err)
-		os.Exit(1)
-	}
+	_, err := req.GetTemplate(cmd.Context(), cl)
+	return err
 }
@@ -0,0 +1,313 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+// newWatchBackoff returns the backoff used between attempts to (re)establish a
+// watch after an error, so that an unreachable API server is not hammered.
+func newWatchBackoff() wait.Backoff {
+	return wait.Backoff{
+		Duration: 500 * time.Millisecond,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    10,
+		Cap:      30 * time.Second,
+	}
+}
+
+// requestWatcher follows an Access Request until it is ready, reporting every
+// condition transition - and, for a PodAccessRequest, the warning events of
+// its Pod - along the way.
+type requestWatcher struct {
+	cmd *cobra.Command
+	cl  client.WithWatch
+	req v1alpha1.IRequestResource
+
+	// Progress is only reported with the text output format
+	outputFormat string
+
+	// The last seen state of each condition, keyed by type
+	conditions map[string]metav1.Condition
+
+	// The Pod whose events are being watched, if any
+	podName string
+
+	// Pod events that have already been reported
+	seenEvents map[string]bool
+}
+
+func newRequestWatcher(
+	cmd *cobra.Command,
+	cl client.WithWatch,
+	req v1alpha1.IRequestResource,
+	outputFormat string,
+) *requestWatcher {
+	return &requestWatcher{
+		cmd:          cmd,
+		cl:           cl,
+		req:          req,
+		outputFormat: outputFormat,
+		conditions:   map[string]metav1.Condition{},
+		seenEvents:   map[string]bool{},
+	}
+}
+
+// wait blocks until the request is ready, the request is deleted or the
+// context is done. Errors talking to the API server are retried with a
+// backoff. The API server also closes every watch after a while - that is
+// not an error, and the watch is quietly re-established.
+func (w *requestWatcher) wait(ctx context.Context) error {
+	key := types.NamespacedName{Name: w.req.GetName(), Namespace: w.req.GetNamespace()}
+
+	podEvents := make(chan *corev1.Event)
+	backoff := newWatchBackoff()
+
+	for {
+		// Get the current state of the request - both to catch up on anything
+		// we missed, and to find the resourceVersion to watch from.
+		err := w.cl.Get(ctx, key, w.req)
+		if apierrors.IsNotFound(err) {
+			return errRequestDeleted{name: key.Name}
+		}
+		if err == nil {
+			if w.observe(ctx, podEvents) {
+				return nil
+			}
+			err = w.watchRequest(ctx, podEvents, &backoff)
+			if err == nil {
+				return nil
+			}
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var deleted errRequestDeleted
+		if errors.As(err, &deleted) {
+			return err
+		}
+		if errors.Is(err, errWatchClosed) {
+			continue
+		}
+
+		// Back off before trying again - but never past our deadline
+		delay := backoff.Step()
+		if w.outputFormat == OutputFormatText {
+			w.cmd.Printf(logWarning("Error watching %s, retrying in %s: %s\n"), key.Name, delay.Round(time.Millisecond), err)
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// errRequestDeleted is returned when the request is deleted while we wait for
+// it - eg. because it was denied, or expired.
+type errRequestDeleted struct{ name string }
+
+func (e errRequestDeleted) Error() string { return fmt.Sprintf("%s was deleted", e.name) }
+
+// errWatchClosed is returned when the API server closes the watch normally,
+// eg. once its timeout is reached.
+var errWatchClosed = errors.New("watch closed")
+
+// watchRequest watches the request from its current resourceVersion, until it
+// is ready (returning nil) or the watch ends (returning an error, or
+// errWatchClosed if the API server closed it normally).
+func (w *requestWatcher) watchRequest(
+	ctx context.Context,
+	podEvents chan *corev1.Event,
+	backoff *wait.Backoff,
+) error {
+	list, err := newListFor(w.cl.Scheme(), w.req)
+	if err != nil {
+		return err
+	}
+	watcher, err := w.cl.Watch(ctx, list,
+		client.InNamespace(w.req.GetNamespace()),
+		client.MatchingFields{"metadata.name": w.req.GetName()},
+		&client.ListOptions{Raw: &metav1.ListOptions{ResourceVersion: w.req.GetResourceVersion()}},
+	)
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case event := <-podEvents:
+			w.reportPodEvent(event)
+
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return errWatchClosed
+			}
+			switch event.Type {
+			case watch.Error:
+				return apierrors.FromObject(event.Object)
+			case watch.Deleted:
+				if obj, ok := event.Object.(client.Object); ok && obj.GetName() == w.req.GetName() {
+					return errRequestDeleted{name: w.req.GetName()}
+				}
+			case watch.Added, watch.Modified:
+				obj, ok := event.Object.(v1alpha1.IRequestResource)
+				if !ok || obj.GetName() != w.req.GetName() {
+					continue
+				}
+				if err := copyInto(w.req, obj); err != nil {
+					return err
+				}
+				*backoff = newWatchBackoff()
+				if w.observe(ctx, podEvents) {
+					return nil
+				}
+			}
+		}
+	}
+}
+
+// observe reports any change to the conditions of the request, starts
+// watching the events of its Pod once it has one, and returns true once the
+// request is ready.
+func (w *requestWatcher) observe(ctx context.Context, podEvents chan *corev1.Event) bool {
+	for _, line := range w.conditionChanges() {
+		if w.outputFormat == OutputFormatText {
+			w.cmd.Println(line)
+		}
+	}
+
+	// Only a PodAccessRequest creates its own Pod, whose events explain why
+	// it is not starting - eg. ImagePullBackOff or Unschedulable.
+	if podReq, ok := w.req.(*v1alpha1.PodAccessRequest); ok && w.podName == "" && podReq.Status.PodName != "" {
+		w.podName = podReq.Status.PodName
+		go w.watchPodEvents(ctx, w.req.GetNamespace(), w.podName, podEvents)
+	}
+
+	return w.req.GetStatus().(v1alpha1.IRequestStatus).IsReady()
+}
+
+// conditionChanges returns a line describing each condition of the request
+// that is new, or has changed, since the last call.
+func (w *requestWatcher) conditionChanges() []string {
+	lines := []string{}
+	for _, cond := range *w.req.GetStatus().GetConditions() {
+		last, seen := w.conditions[cond.Type]
+		if seen && last.Status == cond.Status && last.Reason == cond.Reason && last.Message == cond.Message {
+			continue
+		}
+		w.conditions[cond.Type] = cond
+
+		line := fmt.Sprintf("  %s=%s (%s): %s", cond.Type, cond.Status, cond.Reason, cond.Message)
+		switch cond.Status {
+		case metav1.ConditionTrue:
+			lines = append(lines, logSuccess(line))
+		case metav1.ConditionFalse:
+			lines = append(lines, logWarning(line))
+		default:
+			lines = append(lines, logNotice(line))
+		}
+	}
+	return lines
+}
+
+// watchPodEvents sends the Warning events of the Pod to the channel until the
+// context is done. It runs in its own goroutine, so must not touch w.req or
+// w.podName. This is best-effort - if the user cannot watch events, it
+// quietly gives up.
+func (w *requestWatcher) watchPodEvents(
+	ctx context.Context,
+	namespace string,
+	podName string,
+	events chan<- *corev1.Event,
+) {
+	backoff := newWatchBackoff()
+	for ctx.Err() == nil {
+		watcher, err := w.cl.Watch(ctx, &corev1.EventList{},
+			client.InNamespace(namespace),
+			client.MatchingFields{
+				"involvedObject.kind": "Pod",
+				"involvedObject.name": podName,
+			},
+		)
+		if apierrors.IsForbidden(err) || apierrors.IsUnauthorized(err) {
+			return
+		}
+		if err == nil {
+			for event := range watcher.ResultChan() {
+				if e, ok := event.Object.(*corev1.Event); ok && e.Type == corev1.EventTypeWarning && e.InvolvedObject.Name == podName {
+					select {
+					case events <- e:
+					case <-ctx.Done():
+						watcher.Stop()
+						return
+					}
+				}
+			}
+			watcher.Stop()
+		}
+		select {
+		case <-time.After(backoff.Step()):
+		case <-ctx.Done():
+		}
+	}
+}
+
+// reportPodEvent prints the Pod event, unless it has been reported already.
+// Repeats of an event (eg. BackOff) are only reported once.
+func (w *requestWatcher) reportPodEvent(event *corev1.Event) {
+	key := fmt.Sprintf("%s/%s/%s", event.UID, event.Reason, event.Message)
+	if w.seenEvents[key] {
+		return
+	}
+	w.seenEvents[key] = true
+	if w.outputFormat == OutputFormatText {
+		w.cmd.Printf(logWarning("  Pod %s: %s: %s\n"), w.podName, event.Reason, event.Message)
+	}
+}
+
+// newListFor returns an empty list object for the kind of the supplied object,
+// eg. an ExecAccessRequestList for an ExecAccessRequest.
+func newListFor(scheme *runtime.Scheme, obj client.Object) (client.ObjectList, error) {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return nil, err
+	}
+	list, err := scheme.New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err != nil {
+		return nil, err
+	}
+	return list.(client.ObjectList), nil
+}
+
+// copyInto overwrites dst with src, which must be of the same type. This
+// keeps the caller's request object up to date with what the watch returns.
+func copyInto(dst, src v1alpha1.IRequestResource) error {
+	dv, sv := reflect.ValueOf(dst), reflect.ValueOf(src)
+	if dv.Type() != sv.Type() || dv.Kind() != reflect.Pointer {
+		return fmt.Errorf("cannot copy %T into %T", src, dst)
+	}
+	dv.Elem().Set(sv.Elem())
+	return nil
+}
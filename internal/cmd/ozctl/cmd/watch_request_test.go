@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	api "github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+func newWatchTestScheme(t *testing.T) *runtime.Scheme {
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := api.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestConditionChanges(t *testing.T) {
+	req := &api.PodAccessRequest{}
+	w := newRequestWatcher(&cobra.Command{}, nil, req, OutputFormatText)
+
+	req.Status.Conditions = []metav1.Condition{
+		{Type: "TargetTemplateExists", Status: metav1.ConditionTrue, Reason: "Success", Message: "Found"},
+		{Type: "AccessResourcesReady", Status: metav1.ConditionFalse, Reason: "NotReady", Message: "Pod is Pending"},
+	}
+	if got := w.conditionChanges(); len(got) != 2 {
+		t.Fatalf("expected both new conditions to be reported, got %v", got)
+	}
+
+	// Nothing changed, nothing to report
+	if got := w.conditionChanges(); len(got) != 0 {
+		t.Fatalf("expected no changes, got %v", got)
+	}
+
+	// Only the transition is reported
+	req.Status.Conditions[1].Status = metav1.ConditionTrue
+	req.Status.Conditions[1].Message = "Pod is Running"
+	got := w.conditionChanges()
+	if len(got) != 1 || !strings.Contains(got[0], "AccessResourcesReady=True") ||
+		!strings.Contains(got[0], "Pod is Running") {
+		t.Fatalf("expected the AccessResourcesReady transition, got %v", got)
+	}
+}
+
+func TestRequestWatcherWait(t *testing.T) {
+	req := &api.PodAccessRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "user-abc12", Namespace: "ns"},
+	}
+	cl := fake.NewClientBuilder().WithScheme(newWatchTestScheme(t)).WithObjects(req.DeepCopy()).Build()
+
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	done := make(chan error, 1)
+	waited := req.DeepCopy()
+	go func() { done <- newRequestWatcher(cmd, cl, waited, OutputFormatText).wait(ctx) }()
+
+	// The fake client only delivers changes made after the watch started, so
+	// keep pushing the update until the watcher sees it.
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if waited.Status.PodName != "user-abc12-pod" {
+				t.Errorf("expected the request to be updated, got %+v", waited.Status)
+			}
+			if !strings.Contains(out.String(), "AccessResourcesReady=True") {
+				t.Errorf("expected the condition to be reported, got %q", out.String())
+			}
+			return
+		case <-ticker.C:
+			current := &api.PodAccessRequest{}
+			if err := cl.Get(ctx, types.NamespacedName{Name: "user-abc12", Namespace: "ns"}, current); err != nil {
+				t.Fatal(err)
+			}
+			current.Status.PodName = "user-abc12-pod"
+			current.Status.Ready = true
+			current.Status.Conditions = []metav1.Condition{{
+				Type:               "AccessResourcesReady",
+				Status:             metav1.ConditionTrue,
+				Reason:             "Success",
+				Message:            "Pod is Running",
+				LastTransitionTime: metav1.Now(),
+			}}
+			if err := cl.Update(ctx, current); err != nil {
+				t.Fatal(err)
+			}
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for the watcher")
+		}
+	}
+}
+
+func TestRequestWatcherWaitDeleted(t *testing.T) {
+	cl := fake.NewClientBuilder().WithScheme(newWatchTestScheme(t)).Build()
+	req := &api.ExecAccessRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "missing", Namespace: "ns"},
+	}
+
+	err := newRequestWatcher(&cobra.Command{}, cl, req, OutputFormatText).wait(context.Background())
+	var deleted errRequestDeleted
+	if !errors.As(err, &deleted) {
+		t.Fatalf("expected errRequestDeleted, got %v", err)
+	}
+}
+
+func TestRequestWatcherWaitReconnectsQuietly(t *testing.T) {
+	req := &api.ExecAccessRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "user-abc12", Namespace: "ns"},
+	}
+	ready := req.DeepCopy()
+	ready.Status.Ready = true
+
+	// The first watch is closed by the server straight away, the second one
+	// delivers the ready request.
+	watches := 0
+	cl := fake.NewClientBuilder().
+		WithScheme(newWatchTestScheme(t)).
+		WithObjects(req.DeepCopy()).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Watch: func(_ context.Context, _ client.WithWatch, _ client.ObjectList, _ ...client.ListOption) (watch.Interface, error) {
+				watches++
+				w := watch.NewFakeWithChanSize(1, false)
+				if watches == 1 {
+					w.Stop()
+				} else {
+					w.Modify(ready.DeepCopy())
+				}
+				return w, nil
+			},
+		}).
+		Build()
+
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := newRequestWatcher(cmd, cl, req, OutputFormatText).wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if watches != 2 {
+		t.Errorf("expected the watch to be re-established once, got %d watches", watches)
+	}
+	if strings.Contains(out.String(), "Error watching") {
+		t.Errorf("expected a closed watch not to be reported, got %q", out.String())
+	}
+}
+
+func TestReportPodEvent(t *testing.T) {
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	w := newRequestWatcher(cmd, nil, &api.PodAccessRequest{}, OutputFormatText)
+	w.podName = "user-abc12-pod"
+
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{UID: "1"},
+		Type:       corev1.EventTypeWarning,
+		Reason:     "FailedScheduling",
+		Message:    "0/3 nodes are available: 3 Insufficient cpu.",
+	}
+	w.reportPodEvent(event)
+	w.reportPodEvent(event)
+
+	if got := strings.Count(out.String(), "FailedScheduling"); got != 1 {
+		t.Errorf("expected the event to be reported once, got %d times: %q", got, out.String())
+	}
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// whoAmI asks the API server who the caller is, using a SelfSubjectReview.
+// This is the identity (and groups) that RBAC and the Oz admission webhooks
+// see - which may well differ from the local $USER.
+func whoAmI(ctx context.Context, cl client.Client) (authenticationv1.UserInfo, error) {
+	review := &authenticationv1.SelfSubjectReview{}
+	if err := cl.Create(ctx, review); err != nil {
+		return authenticationv1.UserInfo{}, fmt.Errorf("unable to determine the current user: %w", err)
+	}
+	return review.Status.UserInfo, nil
+}
+
+// listObjects lists every object of the kind of newObj in the namespace. If
+// the kind is not installed in the cluster, an empty list is returned.
+func listObjects(
+	ctx context.Context,
+	cl client.Client,
+	namespace string,
+	newObj func() client.Object,
+) ([]runtime.Object, error) {
+	list, err := newListFor(cl.Scheme(), newObj())
+	if err != nil {
+		return nil, err
+	}
+	if err := cl.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return meta.ExtractList(list)
+}
+
+// maxRequestNamePrefixLength keeps the generated request names - and the
+// names of the resources created for them - comfortably short.
+const maxRequestNamePrefixLength = 32
+
+// invalidNameChars matches everything that cannot appear in a resource name.
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// sanitizeRequestNamePrefix turns a username into something that can be used
+// to prefix the name of a resource. For example "Jane.Doe@example.com" becomes
+// "jane-doe", and "system:serviceaccount:ci:deployer" becomes "ci-deployer".
+// The full username is still recorded in the RequestedBy field of the request.
+func sanitizeRequestNamePrefix(username string) string {
+	name := strings.ToLower(username)
+	name = strings.TrimPrefix(name, "system:serviceaccount:")
+	if at := strings.Index(name, "@"); at > 0 {
+		name = name[:at]
+	}
+	name = invalidNameChars.ReplaceAllString(name, "-")
+
+	// Names must start with a letter, and end with a letter or number
+	name = strings.TrimLeft(name, "-0123456789")
+	if len(name) > maxRequestNamePrefixLength {
+		name = name[:maxRequestNamePrefixLength]
+	}
+	return strings.TrimRight(name, "-")
+}
+
+// resolveRequestNamePrefix returns the request name prefix for the caller,
+// based on their Kubernetes username. If that cannot be determined - eg. the
+// cluster does not support SelfSubjectReviews - the fallback (normally $USER)
+// is used instead.
+func resolveRequestNamePrefix(ctx context.Context, cl client.Client, fallback string) (string, error) {
+	if cl != nil {
+		user, err := whoAmI(ctx, cl)
+		if err == nil {
+			if prefix := sanitizeRequestNamePrefix(user.Username); prefix != "" {
+				return prefix, nil
+			}
+		}
+	}
+	if prefix := sanitizeRequestNamePrefix(fallback); prefix != "" {
+		return prefix, nil
+	}
+	return "", errors.New("unable to determine your username from Kubernetes or $USER, please supply --request-name")
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestWhoAmI(t *testing.T) {
+	cl := fake.NewClientBuilder().WithScheme(newWatchTestScheme(t)).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+			obj.(*authenticationv1.SelfSubjectReview).Status.UserInfo = authenticationv1.UserInfo{
+				Username: "jane@example.com",
+				Groups:   []string{"devs"},
+			}
+			return nil
+		},
+	}).Build()
+
+	user, err := whoAmI(context.Background(), cl)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.Username != "jane@example.com" || len(user.Groups) != 1 {
+		t.Errorf("unexpected user: %+v", user)
+	}
+}
+
+func TestSanitizeRequestNamePrefix(t *testing.T) {
+	for username, want := range map[string]string{
+		"jane":                                "jane",
+		"Jane.Doe@example.com":                "jane-doe",
+		"system:serviceaccount:ci:deployer":   "ci-deployer",
+		"oidc:Jane Doe":                       "oidc-jane-doe",
+		"arn:aws:iam::123456789012:role/Dev_": "arn-aws-iam-123456789012-role-de",
+		"1234-build":                          "build",
+		"!!!":                                 "",
+		"":                                    "",
+	} {
+		if got := sanitizeRequestNamePrefix(username); got != want {
+			t.Errorf("sanitizeRequestNamePrefix(%q) = %q, want %q", username, got, want)
+		}
+	}
+}
+
+func TestResolveRequestNamePrefix(t *testing.T) {
+	newClient := func(username string, err error) client.Client {
+		return fake.NewClientBuilder().WithScheme(newWatchTestScheme(t)).WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+				obj.(*authenticationv1.SelfSubjectReview).Status.UserInfo.Username = username
+				return err
+			},
+		}).Build()
+	}
+	ctx := context.Background()
+
+	// The Kubernetes username wins over $USER
+	if got, err := resolveRequestNamePrefix(ctx, newClient("jane@example.com", nil), "root"); err != nil || got != "jane" {
+		t.Errorf("expected jane, got %q (%v)", got, err)
+	}
+
+	// $USER is only a fallback
+	if got, err := resolveRequestNamePrefix(ctx, newClient("", errors.New("not found")), "bob"); err != nil || got != "bob" {
+		t.Errorf("expected bob, got %q (%v)", got, err)
+	}
+	if got, err := resolveRequestNamePrefix(ctx, nil, "bob"); err != nil || got != "bob" {
+		t.Errorf("expected bob without a client, got %q (%v)", got, err)
+	}
+
+	if _, err := resolveRequestNamePrefix(ctx, nil, ""); err == nil {
+		t.Error("expected an error when no username can be found")
+	}
+}
@@ -103,6 +103,28 @@ func SetAccessNotValid(
 	)
 }
 
+// SetAccessRevoked updates the ConditionAccessStillValid condition to False
+// when the request has been revoked before it expired.
+func SetAccessRevoked(
+	ctx context.Context,
+	rec hasStatusReconciler,
+	req v1alpha1.IRequestResource,
+) error {
+	message := "Access revoked"
+	if revoker := req.GetRevokedBy(); revoker != nil {
+		message = fmt.Sprintf("Access revoked by %s", revoker.Username)
+	}
+	return UpdateCondition(
+		ctx,
+		rec,
+		req,
+		v1alpha1.ConditionAccessStillValid,
+		metav1.ConditionFalse,
+		"Revoked",
+		message,
+	)
+}
+
 // SetAccessStillValid updates the ConditionAccessStillValid condition to True.
 func SetAccessStillValid(
 	ctx context.Context,
@@ -190,6 +212,158 @@ func SetAccessResourcesReady(
 		message)
 }
 
+// SetApprovalGranted updates the ConditionApprovalGranted condition to True.
+func SetApprovalGranted(
+	ctx context.Context,
+	rec hasStatusReconciler,
+	req v1alpha1.IRequestResource,
+	message string,
+) error {
+	return UpdateCondition(
+		ctx,
+		rec,
+		req,
+		v1alpha1.ConditionApprovalGranted,
+		metav1.ConditionTrue,
+		string(metav1.StatusSuccess),
+		message,
+	)
+}
+
+// SetApprovalPending updates the ConditionApprovalGranted condition to False
+// while the request is waiting on more approvals.
+func SetApprovalPending(
+	ctx context.Context,
+	rec hasStatusReconciler,
+	req v1alpha1.IRequestResource,
+	message string,
+) error {
+	return UpdateCondition(
+		ctx,
+		rec,
+		req,
+		v1alpha1.ConditionApprovalGranted,
+		metav1.ConditionFalse,
+		"Pending",
+		message,
+	)
+}
+
+// SetApprovalDenied updates the ConditionApprovalGranted condition to False
+// when an approver has denied the request.
+func SetApprovalDenied(
+	ctx context.Context,
+	rec hasStatusReconciler,
+	req v1alpha1.IRequestResource,
+	message string,
+) error {
+	return UpdateCondition(
+		ctx,
+		rec,
+		req,
+		v1alpha1.ConditionApprovalGranted,
+		metav1.ConditionFalse,
+		"Denied",
+		message,
+	)
+}
+
+// SetWithinConcurrencyLimits updates the ConditionWithinConcurrencyLimits
+// condition to True.
+func SetWithinConcurrencyLimits(
+	ctx context.Context,
+	rec hasStatusReconciler,
+	req v1alpha1.IRequestResource,
+	message string,
+) error {
+	return UpdateCondition(
+		ctx,
+		rec,
+		req,
+		v1alpha1.ConditionWithinConcurrencyLimits,
+		metav1.ConditionTrue,
+		string(metav1.StatusSuccess),
+		message,
+	)
+}
+
+// SetConcurrencyLimitExceeded updates the ConditionWithinConcurrencyLimits
+// condition to False while older live requests use up the concurrency limits
+// of the template.
+func SetConcurrencyLimitExceeded(
+	ctx context.Context,
+	rec hasStatusReconciler,
+	req v1alpha1.IRequestResource,
+	message string,
+) error {
+	return UpdateCondition(
+		ctx,
+		rec,
+		req,
+		v1alpha1.ConditionWithinConcurrencyLimits,
+		metav1.ConditionFalse,
+		"ConcurrencyLimitExceeded",
+		message,
+	)
+}
+
+// SetAccessStarted updates the ConditionAccessStarted condition to True once
+// the Spec.startTime of the request has been reached.
+func SetAccessStarted(
+	ctx context.Context,
+	rec hasStatusReconciler,
+	req v1alpha1.IRequestResource,
+	message string,
+) error {
+	return UpdateCondition(
+		ctx,
+		rec,
+		req,
+		v1alpha1.ConditionAccessStarted,
+		metav1.ConditionTrue,
+		string(metav1.StatusSuccess),
+		message,
+	)
+}
+
+// SetAccessScheduled updates the ConditionAccessStarted condition to False
+// while the Spec.startTime of the request is still in the future.
+func SetAccessScheduled(
+	ctx context.Context,
+	rec hasStatusReconciler,
+	req v1alpha1.IRequestResource,
+	message string,
+) error {
+	return UpdateCondition(
+		ctx,
+		rec,
+		req,
+		v1alpha1.ConditionAccessStarted,
+		metav1.ConditionFalse,
+		"Scheduled",
+		message,
+	)
+}
+
+// SetUsageRecorded updates the ConditionUsageRecorded condition to True once
+// the request has been counted in the Status.usageCount of its template.
+func SetUsageRecorded(
+	ctx context.Context,
+	rec hasStatusReconciler,
+	req v1alpha1.IRequestResource,
+	message string,
+) error {
+	return UpdateCondition(
+		ctx,
+		rec,
+		req,
+		v1alpha1.ConditionUsageRecorded,
+		metav1.ConditionTrue,
+		string(metav1.StatusSuccess),
+		message,
+	)
+}
+
 /*
 ITemplateResource Condition Setters
 */
@@ -19,6 +19,8 @@ import (
 //+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=podaccessrequests/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=podaccessrequests/finalizers,verbs=update
 
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=accessapprovals,verbs=get;list;watch;update;patch
+
 //+kubebuilder:rbac:groups=apps,resources=deployments;daemonsets;statefulsets,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
 
@@ -57,9 +59,32 @@ func (r *RequestReconciler) Reconcile(
 	// Run the actual reconciliation an return that result. Pass in the
 	// Component object that's already been populated by the cache.
 	result, err = r.reconcile(rctx)
+
+	// Never wait longer than the remaining lifetime of the access, so that
+	// the request is deleted as soon as it expires.
+	if err == nil {
+		result = requeueAtExpiry(result, rctx.expiresAt, time.Now())
+	}
 	return result, err
 }
 
+// requeueAtExpiry shortens the RequeueAfter of the result so that the next
+// reconcile happens no later than expiresAt. If expiresAt is not known, or
+// has already passed, the result is returned untouched.
+func requeueAtExpiry(result ctrl.Result, expiresAt time.Time, now time.Time) ctrl.Result {
+	if expiresAt.IsZero() {
+		return result
+	}
+	remaining := expiresAt.Sub(now)
+	if remaining <= 0 {
+		return result
+	}
+	if result.RequeueAfter == 0 || remaining < result.RequeueAfter {
+		result.RequeueAfter = remaining
+	}
+	return result
+}
+
 // reconcile() manages the state for a Component through the generic Installers package.
 //
 // revive:disable:confusing-naming
@@ -91,6 +116,13 @@ func (r *RequestReconciler) reconcile(rctx *RequestContext) (ctrl.Result, error)
 		return ctrlrequeue.RequeueError(err)
 	}
 
+	// USAGE: Count the request in the usage of the template, before it can
+	// expire and be deleted.
+	if err := r.recordUsage(rctx, tmpl); err != nil {
+		rctx.log.Error(err, "Error recording the template usage - will requeue")
+		return ctrlrequeue.RequeueError(err)
+	}
+
 	// VERIFICATION: Check the durations on the request and make sure the request has not expired
 	if shouldReturn, result, err := r.verifyDuration(rctx, tmpl); shouldReturn {
 		return result, err
@@ -101,6 +133,25 @@ func (r *RequestReconciler) reconcile(rctx *RequestContext) (ctrl.Result, error)
 		return result, err
 	}
 
+	// VERIFICATION: If the template requires approvals, make sure that enough
+	// approvals exist before we create any access resources.
+	if shouldReturn, result, err := r.verifyApproval(rctx, tmpl); shouldReturn {
+		return result, err
+	}
+
+	// VERIFICATION: If the access is scheduled to start later, wait until
+	// then before we create any access resources.
+	if shouldReturn, result, err := r.verifyStartTime(rctx); shouldReturn {
+		return result, err
+	}
+
+	// VERIFICATION: If the template limits the number of live requests, make
+	// sure that this request fits within those limits. This happens once the
+	// access has started, as scheduled requests only count from then on.
+	if shouldReturn, result, err := r.verifyConcurrencyLimits(rctx, tmpl); shouldReturn {
+		return result, err
+	}
+
 	// VERIFICATION: Make sure all of the access resources are built properly. On any failure,
 	// set up a 30 second delay before the next reconciliation attempt.
 	if shouldReturn, result, err := r.verifyAccessResources(rctx, tmpl); shouldReturn {
@@ -28,6 +28,7 @@ var _ = Describe("RequestReconciler", Ordered, func() {
 			ctx        = context.Background()
 			ns         *v1.Namespace
 			request    *v1alpha1.ExecAccessRequest
+			template   *v1alpha1.ExecAccessTemplate
 			reconciler *RequestReconciler
 			builder    = &mockBuilder{}
 		)
@@ -42,6 +43,28 @@ var _ = Describe("RequestReconciler", Ordered, func() {
 			err := k8sClient.Create(ctx, ns)
 			Expect(err).ToNot(HaveOccurred())
 
+			By("Should have an ExecAccessTemplate for the mockBuilder to return")
+			template = &v1alpha1.ExecAccessTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.ExecAccessTemplateSpec{
+					AccessConfig: v1alpha1.AccessConfig{
+						AllowedGroups:   []string{"foo"},
+						DefaultDuration: "1h",
+						MaxDuration:     "2h",
+					},
+					ControllerTargetRef: &v1alpha1.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "fake",
+					},
+				},
+			}
+			err = k8sClient.Create(ctx, template)
+			Expect(err).ToNot(HaveOccurred())
+
 			By("Should have an ExecAccessRequest built to test against")
 			request = &v1alpha1.ExecAccessRequest{
 				ObjectMeta: metav1.ObjectMeta{
@@ -87,7 +110,7 @@ var _ = Describe("RequestReconciler", Ordered, func() {
 		It("Reconcile() should work", func() {
 			// Make the Mock return success on GetTemplate()
 			builder.getTemplateErr = nil
-			builder.getTemplateResp = &v1alpha1.ExecAccessTemplate{}
+			builder.getTemplateResp = template
 
 			// Make the Mock return success on GetAccessDuration()
 			builder.getDurationErr = nil
@@ -162,6 +185,14 @@ var _ = Describe("RequestReconciler", Ordered, func() {
 			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
 			Expect(cond.Reason).To(Equal(string(metav1.StatusSuccess)))
 
+			// ConditionUsageRecorded = True
+			cond = meta.FindStatusCondition(
+				*request.GetStatus().GetConditions(),
+				v1alpha1.ConditionUsageRecorded.String(),
+			)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+
 			// Ready Status was set to true
 			Expect(request.Status.IsReady()).To(BeTrue())
 		})
@@ -208,7 +239,7 @@ var _ = Describe("RequestReconciler", Ordered, func() {
 		It("Reconcile() should requeue if verifyAccessResources returns an error", func() {
 			// Make the Mock return success on GetTemplate()
 			builder.getTemplateErr = nil
-			builder.getTemplateResp = &v1alpha1.ExecAccessTemplate{}
+			builder.getTemplateResp = template
 
 			// Make the Mock return success on GetAccessDuration()
 			builder.getDurationErr = nil
@@ -276,7 +307,7 @@ var _ = Describe("RequestReconciler", Ordered, func() {
 		It("Reconcile() should requeue if isAccessExpired returns an error", func() {
 			// Make the Mock return success on GetTemplate()
 			builder.getTemplateErr = nil
-			builder.getTemplateResp = &v1alpha1.ExecAccessTemplate{}
+			builder.getTemplateResp = template
 
 			// Make the Mock return valid results
 			builder.getDurationErr = nil
@@ -0,0 +1,58 @@
+package requestcontroller
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/controllers/internal/status"
+)
+
+// recordUsage counts the request in the Status.usageCount of its template,
+// and moves the Status.lastUsedTime of the template forward. This happens on
+// the first reconcile of the request - before it can expire and be deleted -
+// so that every request is counted, no matter how short-lived it is.
+//
+// The ConditionUsageRecorded condition marks the request as counted. Should
+// that condition fail to save after the template was updated, the request is
+// counted again on the next reconcile.
+func (r *RequestReconciler) recordUsage(
+	rctx *RequestContext,
+	tmpl v1alpha1.ITemplateResource,
+) error {
+	if meta.IsStatusConditionTrue(
+		*rctx.obj.GetStatus().GetConditions(),
+		v1alpha1.ConditionUsageRecorded.String(),
+	) {
+		rctx.log.V(1).Info("Usage has already been recorded on the template")
+		return nil
+	}
+
+	created := rctx.obj.GetCreationTimestamp()
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := r.APIReader.Get(rctx.Context, client.ObjectKeyFromObject(tmpl), tmpl); err != nil {
+			return err
+		}
+		templateStatus, ok := tmpl.GetStatus().(v1alpha1.ITemplateStatus)
+		if !ok {
+			return fmt.Errorf("%T does not record its usage", tmpl)
+		}
+		usage := templateStatus.GetUsage()
+		usage.UsageCount++
+		if usage.LastUsedTime == nil || usage.LastUsedTime.Before(&created) {
+			usage.LastUsedTime = &created
+		}
+		return r.Status().Update(rctx.Context, tmpl)
+	})
+	if err != nil {
+		return err
+	}
+
+	return status.SetUsageRecorded(
+		rctx.Context, r, rctx.obj,
+		fmt.Sprintf("Counted in the usage of %s", tmpl.GetName()),
+	)
+}
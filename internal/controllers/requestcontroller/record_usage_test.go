@@ -0,0 +1,158 @@
+package requestcontroller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/controllers/internal/status"
+	"github.com/diranged/oz/internal/testing/utils"
+)
+
+var _ = Describe("RequestReconciler", Ordered, func() {
+	/*
+		recordUsage() Tests
+	*/
+	Context("recordUsage()", func() {
+		var (
+			ctx        = context.Background()
+			ns         *v1.Namespace
+			request    *v1alpha1.ExecAccessRequest
+			template   *v1alpha1.ExecAccessTemplate
+			reconciler *RequestReconciler
+			rctx       *RequestContext
+		)
+
+		getUsage := func() *v1alpha1.TemplateUsage {
+			fetched := &v1alpha1.ExecAccessTemplate{}
+			err := k8sClient.Get(ctx, types.NamespacedName{
+				Name:      template.Name,
+				Namespace: template.Namespace,
+			}, fetched)
+			Expect(err).To(Not(HaveOccurred()))
+			return fetched.Status.GetUsage()
+		}
+
+		BeforeEach(func() {
+			By("Should have a namespace to execute tests in")
+			ns = &v1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: testutil.RandomString(8),
+				},
+			}
+			err := k8sClient.Create(ctx, ns)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Should have an ExecAccessTemplate to test against")
+			template = &v1alpha1.ExecAccessTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.ExecAccessTemplateSpec{
+					AccessConfig: v1alpha1.AccessConfig{
+						AllowedGroups:   []string{"foo"},
+						DefaultDuration: "1h",
+						MaxDuration:     "2h",
+					},
+					ControllerTargetRef: &v1alpha1.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "fake",
+					},
+				},
+			}
+			err = k8sClient.Create(ctx, template)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Should have an ExecAccessRequest built to test against")
+			request = &v1alpha1.ExecAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.ExecAccessRequestSpec{
+					TemplateName: template.GetName(),
+				},
+			}
+			err = k8sClient.Create(ctx, request)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Creating the RequestReconciler")
+			reconciler = &RequestReconciler{
+				Client:                 k8sClient,
+				Scheme:                 k8sClient.Scheme(),
+				APIReader:              k8sClient,
+				RequestType:            &v1alpha1.ExecAccessRequest{},
+				Builder:                &mockBuilder{},
+				ReconciliationInterval: time.Minute,
+			}
+
+			By("Creating the RequestContext")
+			rctx = newRequestContext(
+				ctx,
+				reconciler.RequestType,
+				reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Name:      request.GetName(),
+						Namespace: request.GetNamespace(),
+					},
+				},
+			)
+			err = reconciler.fetchRequestObject(rctx)
+			Expect(err).To(BeNil())
+		})
+
+		AfterEach(func() {
+			By("Should delete the namespace")
+			err := k8sClient.Delete(ctx, ns)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("recordUsage() should count the request in the template usage once", func() {
+			Expect(reconciler.recordUsage(rctx, template)).To(Succeed())
+
+			// VERIFY: The request was counted, and marked as counted
+			usage := getUsage()
+			Expect(usage.UsageCount).To(Equal(int64(1)))
+			Expect(usage.LastUsedTime).ToNot(BeNil())
+			Expect(usage.LastUsedTime.Time).To(BeTemporally("==", rctx.obj.GetCreationTimestamp().Time))
+			Expect(meta.IsStatusConditionTrue(
+				*rctx.obj.GetStatus().GetConditions(),
+				v1alpha1.ConditionUsageRecorded.String(),
+			)).To(BeTrue())
+
+			// VERIFY: Later reconciles do not count it again
+			Expect(reconciler.recordUsage(rctx, template)).To(Succeed())
+			Expect(getUsage().UsageCount).To(Equal(int64(1)))
+		})
+
+		It("recordUsage() should count requests that expire before the template is reconciled", func() {
+			By("Expiring the request right away")
+			Expect(status.SetAccessNotValid(ctx, reconciler, rctx.obj)).To(Succeed())
+
+			Expect(reconciler.recordUsage(rctx, template)).To(Succeed())
+			shouldReturn, _, err := reconciler.isAccessExpired(rctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeTrue())
+
+			// VERIFY: The request is gone, but it was counted
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      request.Name,
+				Namespace: request.Namespace,
+			}, request)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			Expect(getUsage().UsageCount).To(Equal(int64(1)))
+		})
+	})
+})
@@ -1,14 +1,96 @@
 package requestcontroller
 
 import (
-	ctrlutil "github.com/diranged/oz/internal/controllers/internal/utils"
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/builders"
+	ctrlutil "github.com/diranged/oz/internal/controllers/internal/utils"
 )
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *RequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(r.RequestType).
-		WithEventFilter(ctrlutil.IgnoreStatusUpdatesAndDeletion()).
-		Complete(r)
+	gvk, err := apiutil.GVKForObject(r.RequestType, mgr.GetScheme())
+	if err != nil {
+		return err
+	}
+
+	// Our Reconcile() loops make many updates to the status fields of the
+	// Access Requests, so those updates are filtered out. The filter is not
+	// applied to the owned Pods though - it is precisely their status
+	// changes (eg. becoming Ready) that we need to react to.
+	ignoreStatusUpdates := builder.WithPredicates(ctrlutil.IgnoreStatusUpdatesAndDeletion())
+
+	bldr := ctrl.NewControllerManagedBy(mgr).
+		For(r.RequestType, ignoreStatusUpdates).
+		Owns(&corev1.Pod{}).
+		Watches(
+			&v1alpha1.AccessApproval{},
+			handler.EnqueueRequestsFromMapFunc(approvalToRequests(gvk.Kind)),
+			ignoreStatusUpdates,
+		)
+
+	// Builders whose access resources cover all of the Pods of the target
+	// controller need to be re-run as those Pods come and go. Only Pods
+	// being created, deleted or relabelled can change which Pods belong to
+	// the controller - every other Pod update is ignored.
+	if _, ok := r.Builder.(builders.IPodWatchingBuilder); ok {
+		bldr = bldr.Watches(
+			&corev1.Pod{},
+			handler.EnqueueRequestsFromMapFunc(podToRequests(r.Client, gvk)),
+			builder.WithPredicates(predicate.LabelChangedPredicate{}),
+		)
+	}
+
+	return bldr.Complete(r)
+}
+
+// approvalToRequests maps an AccessApproval back to the Access Request (of the
+// supplied Kind) that it references, so that new approvals trigger an
+// immediate reconciliation of the request.
+func approvalToRequests(kind string) handler.MapFunc {
+	return func(_ context.Context, obj client.Object) []ctrl.Request {
+		approval, ok := obj.(*v1alpha1.AccessApproval)
+		if !ok || approval.Spec.RequestKind != kind {
+			return nil
+		}
+		return []ctrl.Request{{NamespacedName: client.ObjectKey{
+			Namespace: approval.GetNamespace(),
+			Name:      approval.Spec.RequestName,
+		}}}
+	}
+}
+
+// podToRequests maps a Pod to every Access Request (of the supplied Kind) in
+// the same namespace. The Access Requests are only listed by their metadata,
+// the Builder decides which Pods are relevant to each of them.
+func podToRequests(cl client.Client, gvk schema.GroupVersionKind) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []ctrl.Request {
+		list := &metav1.PartialObjectMetadataList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := cl.List(ctx, list, client.InNamespace(obj.GetNamespace())); err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to list Access Requests for Pod", "pod", obj.GetName())
+			return nil
+		}
+
+		requests := []ctrl.Request{}
+		for _, item := range list.Items {
+			requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKey{
+				Namespace: item.GetNamespace(),
+				Name:      item.GetName(),
+			}})
+		}
+		return requests
+	}
 }
@@ -20,6 +20,9 @@ import (
 // CreateAccessResources() method, but the AccessResourcesAreReady() method
 // returns False indicating that the resources have not yet completed their
 // readiness checks.
+//
+// For Pods owned by the Access Request, this is only a fallback - status
+// changes on those Pods trigger a reconcile immediately.
 var DefaultVerifyResourcesRequeueInterval = (5 * time.Second)
 
 // RequestReconciler is configured watch for a particular type (RequestType) of
@@ -67,6 +70,10 @@ type RequestContext struct {
 	obj          v1alpha1.IRequestResource
 	req          ctrl.Request
 	log          logr.Logger
+
+	// expiresAt is populated by verifyDuration() with the time at which the
+	// access expires, so that the reconcile can be requeued right then.
+	expiresAt time.Time
 }
 
 func newRequestContext(
@@ -0,0 +1,131 @@
+package requestcontroller
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/controllers/internal/ctrlrequeue"
+	"github.com/diranged/oz/internal/controllers/internal/status"
+)
+
+// verifyApproval checks whether or not the template requires approvals for
+// the request, and if so whether enough AccessApproval resources have been
+// created by members of the ApprovalConfig.ApproverGroups. AccessApprovals
+// created by the requester themselves are ignored. Until the request
+// has been approved, reconciliation ends here and no access resources are
+// created.
+//
+// Once the ConditionApprovalGranted condition is True, it is never
+// re-evaluated.
+func (r *RequestReconciler) verifyApproval(
+	rctx *RequestContext,
+	tmpl v1alpha1.ITemplateResource,
+) (shouldEndReconcile bool, result ctrl.Result, resultErr error) {
+	approvalConfig := tmpl.GetAccessConfig().GetApprovalConfig()
+	if approvalConfig == nil {
+		rctx.log.V(1).Info("Template does not require approvals")
+		return false, result, nil
+	}
+
+	if meta.IsStatusConditionTrue(
+		*rctx.obj.GetStatus().GetConditions(),
+		v1alpha1.ConditionApprovalGranted.String(),
+	) {
+		rctx.log.V(1).Info("Request has already been approved")
+		return false, result, nil
+	}
+
+	rctx.log.V(1).Info("Checking for AccessApprovals...")
+	gvk, err := apiutil.GVKForObject(rctx.obj, r.Scheme)
+	if err != nil {
+		return true, result, err
+	}
+
+	approvals := &v1alpha1.AccessApprovalList{}
+	if err := r.List(rctx.Context, approvals, client.InNamespace(rctx.obj.GetNamespace())); err != nil {
+		return true, result, err
+	}
+
+	approvers := []string{}
+	for i := range approvals.Items {
+		approval := &approvals.Items[i]
+		if !approval.IsFor(gvk.Kind, rctx.obj.GetName()) {
+			continue
+		}
+
+		// Make sure that the AccessApproval is cleaned up along with the request.
+		if err := r.setApprovalOwnerReference(rctx, approval); err != nil {
+			return true, result, err
+		}
+
+		if approval.IsByRequester(rctx.obj) {
+			rctx.log.Info(fmt.Sprintf(
+				"Ignoring AccessApproval %s from %s, requesters cannot approve their own requests",
+				approval.GetName(), approval.Spec.Approver,
+			))
+			continue
+		}
+
+		if !approvalConfig.IsApprover(approval.Spec.ApproverGroups) {
+			rctx.log.Info(fmt.Sprintf(
+				"Ignoring AccessApproval %s from %s, not a member of the approver groups",
+				approval.GetName(), approval.Spec.Approver,
+			))
+			continue
+		}
+
+		if !approval.IsApproved() {
+			msg := fmt.Sprintf("Denied by %s", approval.Spec.Approver)
+			if approval.Spec.Reason != "" {
+				msg = fmt.Sprintf("%s: %s", msg, approval.Spec.Reason)
+			}
+			rctx.log.Info(msg)
+			if err := status.SetApprovalDenied(rctx.Context, r, rctx.obj, msg); err != nil {
+				return true, result, err
+			}
+			result, resultErr = ctrlrequeue.RequeueAfter(r.ReconciliationInterval)
+			return true, result, resultErr
+		}
+
+		if !slices.Contains(approvers, approval.Spec.Approver) {
+			approvers = append(approvers, approval.Spec.Approver)
+		}
+	}
+
+	if required := approvalConfig.GetRequiredApprovals(); len(approvers) < required {
+		msg := fmt.Sprintf("Waiting for approvals (%d/%d)", len(approvers), required)
+		rctx.log.Info(msg)
+		if err := status.SetApprovalPending(rctx.Context, r, rctx.obj, msg); err != nil {
+			return true, result, err
+		}
+		result, resultErr = ctrlrequeue.RequeueAfter(r.ReconciliationInterval)
+		return true, result, resultErr
+	}
+
+	msg := fmt.Sprintf("Approved by %s", strings.Join(approvers, ", "))
+	return false, result, status.SetApprovalGranted(rctx.Context, r, rctx.obj, msg)
+}
+
+// setApprovalOwnerReference adds an OwnerReference pointing to the request on
+// the AccessApproval, if it does not already have one.
+func (r *RequestReconciler) setApprovalOwnerReference(
+	rctx *RequestContext,
+	approval *v1alpha1.AccessApproval,
+) error {
+	owned, err := controllerutil.HasOwnerReference(approval.GetOwnerReferences(), rctx.obj, r.Scheme)
+	if err != nil || owned {
+		return err
+	}
+	if err := controllerutil.SetOwnerReference(rctx.obj, approval, r.Scheme); err != nil {
+		return err
+	}
+	return r.Update(rctx.Context, approval)
+}
@@ -0,0 +1,233 @@
+package requestcontroller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/testing/utils"
+)
+
+var _ = Describe("RequestReconciler", Ordered, func() {
+	/*
+		verifyApproval() Tests
+	*/
+	Context("verifyApproval()", func() {
+		var (
+			ctx        = context.Background()
+			ns         *v1.Namespace
+			request    *v1alpha1.ExecAccessRequest
+			template   *v1alpha1.ExecAccessTemplate
+			reconciler *RequestReconciler
+			rctx       *RequestContext
+		)
+
+		createApproval := func(approver string, groups []string, decision v1alpha1.ApprovalDecision) {
+			approval := &v1alpha1.AccessApproval{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.AccessApprovalSpec{
+					RequestName:    request.GetName(),
+					RequestKind:    "ExecAccessRequest",
+					Decision:       decision,
+					Approver:       approver,
+					ApproverGroups: groups,
+				},
+			}
+			Expect(k8sClient.Create(ctx, approval)).To(Succeed())
+		}
+
+		getCondition := func() *metav1.Condition {
+			err := k8sClient.Get(ctx, types.NamespacedName{
+				Name:      request.Name,
+				Namespace: request.Namespace,
+			}, request)
+			Expect(err).To(Not(HaveOccurred()))
+			return meta.FindStatusCondition(
+				*request.GetStatus().GetConditions(),
+				v1alpha1.ConditionApprovalGranted.String(),
+			)
+		}
+
+		BeforeEach(func() {
+			By("Should have a namespace to execute tests in")
+			ns = &v1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: testutil.RandomString(8),
+				},
+			}
+			err := k8sClient.Create(ctx, ns)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Should have an ExecAccessTemplate to test against")
+			template = &v1alpha1.ExecAccessTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.ExecAccessTemplateSpec{
+					AccessConfig: v1alpha1.AccessConfig{
+						AllowedGroups:   []string{"foo"},
+						DefaultDuration: "1h",
+						MaxDuration:     "2h",
+						ApprovalConfig: &v1alpha1.ApprovalConfig{
+							ApproverGroups:    []string{"admins"},
+							RequiredApprovals: 2,
+						},
+					},
+					ControllerTargetRef: &v1alpha1.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "fake",
+					},
+				},
+			}
+			err = k8sClient.Create(ctx, template)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Should have an ExecAccessRequest built to test against")
+			request = &v1alpha1.ExecAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "verifyapproval-test",
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.ExecAccessRequestSpec{
+					TemplateName: template.GetName(),
+					RequestedBy:  &v1alpha1.RequesterInfo{Username: "erin"},
+				},
+			}
+			err = k8sClient.Create(ctx, request)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Creating the RequestReconciler")
+			reconciler = &RequestReconciler{
+				Client:                 k8sClient,
+				Scheme:                 k8sClient.Scheme(),
+				APIReader:              k8sClient,
+				RequestType:            &v1alpha1.ExecAccessRequest{},
+				Builder:                &mockBuilder{},
+				ReconciliationInterval: time.Minute,
+			}
+
+			By("Creating the RequestContext")
+			rctx = newRequestContext(
+				ctx,
+				reconciler.RequestType,
+				reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Name:      request.GetName(),
+						Namespace: request.GetNamespace(),
+					},
+				},
+			)
+			err = reconciler.fetchRequestObject(rctx)
+			Expect(err).To(BeNil())
+		})
+
+		AfterEach(func() {
+			By("Should delete the namespace")
+			err := k8sClient.Delete(ctx, ns)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("verifyApproval() should continue if no approvals are required", func() {
+			template.Spec.AccessConfig.ApprovalConfig = nil
+
+			shouldReturn, result, err := reconciler.verifyApproval(rctx, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeFalse())
+			Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+			Expect(getCondition()).To(BeNil())
+		})
+
+		It("verifyApproval() should wait until enough approvals exist", func() {
+			By("Having no approvals")
+			shouldReturn, result, err := reconciler.verifyApproval(rctx, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeTrue())
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+			cond := getCondition()
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal("Pending"))
+			Expect(cond.Message).To(Equal("Waiting for approvals (0/2)"))
+
+			By("Ignoring approvals from non-approvers, and duplicate approvers")
+			createApproval("bob", []string{"devs"}, v1alpha1.ApprovalDecisionApproved)
+			createApproval("alice", []string{"admins"}, v1alpha1.ApprovalDecisionApproved)
+			createApproval("alice", []string{"admins"}, v1alpha1.ApprovalDecisionApproved)
+			shouldReturn, _, err = reconciler.verifyApproval(rctx, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeTrue())
+			Expect(getCondition().Message).To(Equal("Waiting for approvals (1/2)"))
+
+			By("Having enough approvals")
+			createApproval("carol", []string{"admins"}, v1alpha1.ApprovalDecisionApproved)
+			shouldReturn, _, err = reconciler.verifyApproval(rctx, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeFalse())
+			cond = getCondition()
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Message).To(ContainSubstring("alice"))
+			Expect(cond.Message).To(ContainSubstring("carol"))
+
+			By("Setting OwnerReferences on the approvals")
+			approvals := &v1alpha1.AccessApprovalList{}
+			Expect(k8sClient.List(ctx, approvals)).To(Succeed())
+			for _, approval := range approvals.Items {
+				if approval.GetNamespace() != ns.GetName() {
+					continue
+				}
+				Expect(approval.GetOwnerReferences()).To(HaveLen(1))
+				Expect(approval.GetOwnerReferences()[0].Name).To(Equal(request.GetName()))
+			}
+		})
+
+		It("verifyApproval() should ignore approvals from the requester", func() {
+			By("Ignoring the requester, even though they are an approver")
+			createApproval("erin", []string{"admins"}, v1alpha1.ApprovalDecisionApproved)
+			createApproval("alice", []string{"admins"}, v1alpha1.ApprovalDecisionApproved)
+			shouldReturn, result, err := reconciler.verifyApproval(rctx, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeTrue())
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+			cond := getCondition()
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Message).To(Equal("Waiting for approvals (1/2)"))
+
+			By("Granting access once another approver approves")
+			createApproval("carol", []string{"admins"}, v1alpha1.ApprovalDecisionApproved)
+			shouldReturn, _, err = reconciler.verifyApproval(rctx, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeFalse())
+			cond = getCondition()
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Message).ToNot(ContainSubstring("erin"))
+		})
+
+		It("verifyApproval() should stop on a denial", func() {
+			createApproval("alice", []string{"admins"}, v1alpha1.ApprovalDecisionApproved)
+			createApproval("dave", []string{"admins"}, v1alpha1.ApprovalDecisionDenied)
+
+			shouldReturn, result, err := reconciler.verifyApproval(rctx, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeTrue())
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+			cond := getCondition()
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal("Denied"))
+			Expect(cond.Message).To(Equal("Denied by dave"))
+		})
+	})
+})
@@ -0,0 +1,81 @@
+package requestcontroller
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/controllers/internal/ctrlrequeue"
+	"github.com/diranged/oz/internal/controllers/internal/status"
+)
+
+// verifyConcurrencyLimits re-checks the concurrency limits of the template
+// that the admission webhook enforces, in case a request got past it (eg. two
+// requests created at the same time). Only the live requests that started
+// before this one are counted, so that the oldest requests win. Until
+// the request fits within the limits, reconciliation ends here and no access
+// resources are created.
+//
+// Once the ConditionWithinConcurrencyLimits condition is True, it is never
+// re-evaluated.
+func (r *RequestReconciler) verifyConcurrencyLimits(
+	rctx *RequestContext,
+	tmpl v1alpha1.ITemplateResource,
+) (shouldEndReconcile bool, result ctrl.Result, resultErr error) {
+	accessConfig := tmpl.GetAccessConfig()
+	if !accessConfig.HasConcurrencyLimits() {
+		rctx.log.V(1).Info("Template does not have concurrency limits")
+		return false, result, nil
+	}
+
+	if meta.IsStatusConditionTrue(
+		*rctx.obj.GetStatus().GetConditions(),
+		v1alpha1.ConditionWithinConcurrencyLimits.String(),
+	) {
+		rctx.log.V(1).Info("Request is already within the concurrency limits")
+		return false, result, nil
+	}
+
+	live, err := v1alpha1.ListLiveRequests(
+		rctx.Context, r.Client, rctx.obj, rctx.obj.GetNamespace(), tmpl.GetName(), time.Now(),
+	)
+	if err != nil {
+		return true, result, err
+	}
+	older := []v1alpha1.IRequestResource{}
+	for _, req := range live {
+		if startedBefore(req, rctx.obj) {
+			older = append(older, req)
+		}
+	}
+
+	username := ""
+	if requester := rctx.obj.GetRequestedBy(); requester != nil {
+		username = requester.Username
+	}
+	if err := accessConfig.VerifyConcurrencyLimits(tmpl.GetName(), username, older); err != nil {
+		rctx.log.Info(err.Error())
+		if err := status.SetConcurrencyLimitExceeded(rctx.Context, r, rctx.obj, err.Error()); err != nil {
+			return true, result, err
+		}
+		result, resultErr = ctrlrequeue.RequeueAfter(r.ReconciliationInterval)
+		return true, result, resultErr
+	}
+
+	return false, result, status.SetWithinConcurrencyLimits(
+		rctx.Context, r, rctx.obj, "Within the concurrency limits of the template",
+	)
+}
+
+// startedBefore returns true if the access of a started before that of b -
+// both being requests of the same kind, in the same namespace. Requests that
+// started in the same second are ordered by name.
+func startedBefore(a, b v1alpha1.IRequestResource) bool {
+	aStarted, bStarted := a.GetStartTime(), b.GetStartTime()
+	if !aStarted.Equal(bStarted) {
+		return aStarted.Before(bStarted)
+	}
+	return a.GetName() < b.GetName()
+}
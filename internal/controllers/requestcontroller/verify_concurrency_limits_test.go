@@ -0,0 +1,208 @@
+package requestcontroller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/testing/utils"
+)
+
+var _ = Describe("RequestReconciler", Ordered, func() {
+	/*
+		verifyConcurrencyLimits() Tests
+	*/
+	Context("verifyConcurrencyLimits()", func() {
+		var (
+			ctx        = context.Background()
+			ns         *v1.Namespace
+			request    *v1alpha1.ExecAccessRequest
+			template   *v1alpha1.ExecAccessTemplate
+			reconciler *RequestReconciler
+			rctx       *RequestContext
+		)
+
+		createRequest := func(name, username string) *v1alpha1.ExecAccessRequest {
+			req := &v1alpha1.ExecAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.ExecAccessRequestSpec{
+					TemplateName: template.GetName(),
+					RequestedBy:  &v1alpha1.RequesterInfo{Username: username},
+				},
+			}
+			Expect(k8sClient.Create(ctx, req)).To(Succeed())
+			return req
+		}
+
+		getCondition := func() *metav1.Condition {
+			err := k8sClient.Get(ctx, types.NamespacedName{
+				Name:      request.Name,
+				Namespace: request.Namespace,
+			}, request)
+			Expect(err).To(Not(HaveOccurred()))
+			return meta.FindStatusCondition(
+				*request.GetStatus().GetConditions(),
+				v1alpha1.ConditionWithinConcurrencyLimits.String(),
+			)
+		}
+
+		BeforeEach(func() {
+			By("Should have a namespace to execute tests in")
+			ns = &v1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: testutil.RandomString(8),
+				},
+			}
+			err := k8sClient.Create(ctx, ns)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Should have an ExecAccessTemplate to test against")
+			template = &v1alpha1.ExecAccessTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.ExecAccessTemplateSpec{
+					AccessConfig: v1alpha1.AccessConfig{
+						AllowedGroups:                []string{"foo"},
+						DefaultDuration:              "1h",
+						MaxDuration:                  "2h",
+						MaxConcurrentRequests:        2,
+						MaxConcurrentRequestsPerUser: 1,
+					},
+					ControllerTargetRef: &v1alpha1.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "fake",
+					},
+				},
+			}
+			err = k8sClient.Create(ctx, template)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Should have older ExecAccessRequests from other users")
+			createRequest("aaa-bob", "bob")
+
+			By("Should have an ExecAccessRequest built to test against")
+			request = createRequest("verifyconcurrencylimits-test", "alice")
+
+			By("Creating the RequestReconciler")
+			reconciler = &RequestReconciler{
+				Client:                 k8sClient,
+				Scheme:                 k8sClient.Scheme(),
+				APIReader:              k8sClient,
+				RequestType:            &v1alpha1.ExecAccessRequest{},
+				Builder:                &mockBuilder{},
+				ReconciliationInterval: time.Minute,
+			}
+
+			By("Creating the RequestContext")
+			rctx = newRequestContext(
+				ctx,
+				reconciler.RequestType,
+				reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Name:      request.GetName(),
+						Namespace: request.GetNamespace(),
+					},
+				},
+			)
+			err = reconciler.fetchRequestObject(rctx)
+			Expect(err).To(BeNil())
+		})
+
+		AfterEach(func() {
+			By("Should delete the namespace")
+			err := k8sClient.Delete(ctx, ns)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("verifyConcurrencyLimits() should continue if the template has no limits", func() {
+			template.Spec.AccessConfig.MaxConcurrentRequests = 0
+			template.Spec.AccessConfig.MaxConcurrentRequestsPerUser = 0
+
+			shouldReturn, result, err := reconciler.verifyConcurrencyLimits(rctx, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeFalse())
+			Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+			Expect(getCondition()).To(BeNil())
+		})
+
+		It("verifyConcurrencyLimits() should ignore newer requests", func() {
+			By("Having newer requests that would exceed the limits")
+			createRequest("zzz-alice", "alice")
+			createRequest("zzz-carol", "carol")
+
+			shouldReturn, result, err := reconciler.verifyConcurrencyLimits(rctx, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeFalse())
+			Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+			cond := getCondition()
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("startedBefore() should order requests by start time, then by name", func() {
+			older := request.DeepCopy()
+			older.Name = "aaa-alice"
+			older.CreationTimestamp = metav1.NewTime(request.CreationTimestamp.Add(-time.Hour))
+			Expect(startedBefore(older, request)).To(BeTrue())
+			Expect(startedBefore(request, older)).To(BeFalse())
+
+			older.Name = "zzz-alice"
+			Expect(startedBefore(older, request)).To(BeTrue())
+
+			older.CreationTimestamp = request.CreationTimestamp
+			Expect(startedBefore(older, request)).To(BeFalse())
+			older.Name = "aaa-alice"
+			Expect(startedBefore(older, request)).To(BeTrue())
+			Expect(startedBefore(request, request)).To(BeFalse())
+
+			By("Ordering a request created earlier, but scheduled to start later, after the request")
+			older.CreationTimestamp = metav1.NewTime(request.CreationTimestamp.Add(-time.Hour))
+			older.Spec.StartTime = &metav1.Time{Time: request.CreationTimestamp.Add(time.Hour)}
+			Expect(startedBefore(older, request)).To(BeFalse())
+			Expect(startedBefore(request, older)).To(BeTrue())
+		})
+
+		It("verifyConcurrencyLimits() should stop while the user is over the limit", func() {
+			By("Having an older live request from the same user")
+			rctx.obj.(*v1alpha1.ExecAccessRequest).CreationTimestamp = metav1.NewTime(time.Now().Add(time.Hour))
+			older := createRequest("aaa-alice", "alice")
+
+			shouldReturn, result, err := reconciler.verifyConcurrencyLimits(rctx, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeTrue())
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+			cond := getCondition()
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal("ConcurrencyLimitExceeded"))
+			Expect(cond.Message).To(ContainSubstring("maxConcurrentRequestsPerUser: 1"))
+			Expect(cond.Message).To(ContainSubstring("aaa-alice"))
+
+			By("Revoking the older request")
+			older.Spec.Revoked = true
+			Expect(k8sClient.Update(ctx, older)).To(Succeed())
+			Expect(reconciler.fetchRequestObject(rctx)).To(Succeed())
+			rctx.obj.(*v1alpha1.ExecAccessRequest).CreationTimestamp = metav1.NewTime(time.Now().Add(time.Hour))
+
+			shouldReturn, _, err = reconciler.verifyConcurrencyLimits(rctx, template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeFalse())
+			Expect(getCondition().Status).To(Equal(metav1.ConditionTrue))
+		})
+	})
+})
@@ -2,12 +2,14 @@ package requestcontroller
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/diranged/oz/internal/api/v1alpha1"
 	"github.com/diranged/oz/internal/builders"
 	"github.com/diranged/oz/internal/controllers/internal/ctrlrequeue"
 	"github.com/diranged/oz/internal/controllers/internal/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -45,11 +47,38 @@ func (r *RequestReconciler) verifyDuration(
 		return shouldEndReconcile, result, resultErr
 	}
 
+	// If the template is only usable at certain times, the access must end
+	// when those windows close.
+	accessDuration, decision, err = clampToAllowedWindows(rctx.obj, tmpl, accessDuration, decision)
+	if err != nil {
+		rctx.log.Error(err, "Invalid allowedWindows, will not requeue.")
+		_ = status.SetRequestDurationsNotValid(rctx.Context, r, rctx.obj, err.Error())
+		result, resultErr = ctrlrequeue.NoRequeue()
+		return true, result, resultErr
+	}
+
+	// Record when the access expires - measured from the start of the
+	// access. This is persisted along with the condition below.
+	rctx.expiresAt = rctx.obj.GetStartTime().Add(accessDuration)
+	rctx.obj.GetStatus().(v1alpha1.IRequestStatus).SetExpiresAt(metav1.NewTime(rctx.expiresAt))
+	decision = fmt.Sprintf("%s, expires at %s", decision, rctx.expiresAt.UTC().Format(time.RFC3339))
+
 	// Success, update the resource
 	if err := status.SetRequestDurationsValid(rctx.Context, r, rctx.obj, decision); err != nil {
 		return true, ctrl.Result{}, err
 	}
 
+	// A revoked request is handled just like an expired one - the deletion
+	// happens in the isAccessExpired() step.
+	if rctx.obj.IsRevoked() {
+		revokedBy := ""
+		if revoker := rctx.obj.GetRevokedBy(); revoker != nil {
+			revokedBy = revoker.Username
+		}
+		rctx.log.Info("Access has been revoked", "revokedBy", revokedBy)
+		return false, result, status.SetAccessRevoked(rctx.Context, r, rctx.obj)
+	}
+
 	// If the access is expired at this point, update that condition too.
 	if rctx.obj.GetUptime() > accessDuration {
 		// No we should not end the reconcile - the access is invalid ... but
@@ -61,3 +90,38 @@ func (r *RequestReconciler) verifyDuration(
 	// End by setting the access to still-valid
 	return false, result, status.SetAccessStillValid(rctx.Context, r, rctx.obj)
 }
+
+// clampToAllowedWindows shortens the accessDuration of the request so that the
+// access ends when the allowedWindows of the template close, and records that
+// in the decision. Access that starts outside of the windows is clamped to
+// nothing at all.
+func clampToAllowedWindows(
+	req v1alpha1.IRequestResource,
+	tmpl v1alpha1.ITemplateResource,
+	accessDuration time.Duration,
+	decision string,
+) (time.Duration, string, error) {
+	accessConfig := tmpl.GetAccessConfig()
+	if !accessConfig.HasAllowedWindows() {
+		return accessDuration, decision, nil
+	}
+
+	start := req.GetStartTime()
+	closes, open, err := accessConfig.GetWindowClose(start)
+	if err != nil {
+		return accessDuration, decision, err
+	}
+	if !open {
+		return 0, fmt.Sprintf(
+			"%s, clamped to 0s because access starts outside of the allowedWindows (%s)",
+			decision, accessConfig.DescribeAllowedWindows(),
+		), nil
+	}
+	if remaining := closes.Sub(start); remaining < accessDuration {
+		return remaining, fmt.Sprintf(
+			"%s, clamped to %s because the allowed window closes at %s",
+			decision, remaining, closes.UTC().Format(time.RFC3339),
+		), nil
+	}
+	return accessDuration, decision, nil
+}
@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/diranged/oz/internal/api/v1alpha1"
@@ -307,6 +308,198 @@ var _ = Describe("RequestReconciler", Ordered, func() {
 			Expect(cond).ToNot(BeNil())
 			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
 			Expect(cond.Reason).To(Equal("Success"))
+
+			// VERIFY: The expiry was recorded in the status, and the context
+			expiresAt := request.GetCreationTimestamp().Add(time.Hour)
+			Expect(request.Status.ExpiresAt).ToNot(BeNil())
+			Expect(request.Status.ExpiresAt.Time).To(BeTemporally("==", expiresAt))
+			Expect(rctx.expiresAt).To(BeTemporally("==", expiresAt))
+
+			// VERIFY: The decision records the expiry too
+			cond = meta.FindStatusCondition(
+				*request.GetStatus().GetConditions(),
+				string(v1alpha1.ConditionRequestDurationsValid.String()),
+			)
+			Expect(cond.Message).To(HaveSuffix(
+				fmt.Sprintf(", expires at %s", expiresAt.UTC().Format(time.RFC3339)),
+			))
+		})
+
+		It("verifyDuration() should measure the duration from the start time", func() {
+			// Schedule the access to start well after the request was created
+			builder.getDurationErr = nil
+			builder.getDurationResp = time.Hour
+			obj := rctx.obj.(*v1alpha1.ExecAccessRequest)
+			startTime := metav1.NewTime(obj.GetCreationTimestamp().Add(2 * time.Hour))
+			obj.Spec.StartTime = &startTime
+			defer func() { obj.Spec.StartTime = nil }()
+
+			shouldEndReconcile, _, err := reconciler.verifyDuration(rctx, template)
+			Expect(shouldEndReconcile).To(BeFalse())
+			Expect(err).To(BeNil())
+
+			By("Refetching our Request...")
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      request.Name,
+				Namespace: request.Namespace,
+			}, request)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// VERIFY: The expiry is an hour after the start time
+			expiresAt := startTime.Add(time.Hour)
+			Expect(request.Status.ExpiresAt).ToNot(BeNil())
+			Expect(request.Status.ExpiresAt.Time).To(BeTemporally("==", expiresAt))
+			Expect(rctx.expiresAt).To(BeTemporally("==", expiresAt))
+
+			// VERIFY: The access has not run out while it is scheduled
+			cond := meta.FindStatusCondition(
+				*request.GetStatus().GetConditions(),
+				string(v1alpha1.ConditionAccessStillValid.String()),
+			)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("verifyDuration() should clamp the duration to the allowed windows", func() {
+			// The template is only usable for a little while longer. The
+			// window may cross midnight, which is handled just the same.
+			builder.getDurationErr = nil
+			builder.getDurationResp = time.Hour
+			created := rctx.obj.GetCreationTimestamp().UTC()
+			closes := created.Add(30 * time.Minute).Truncate(time.Minute)
+			template.Spec.AccessConfig.AllowedWindows = []v1alpha1.AccessWindow{{
+				Start: created.Add(-time.Hour).Format("15:04"),
+				End:   closes.Format("15:04"),
+			}}
+			defer func() { template.Spec.AccessConfig.AllowedWindows = nil }()
+
+			shouldEndReconcile, _, err := reconciler.verifyDuration(rctx, template)
+			Expect(shouldEndReconcile).To(BeFalse())
+			Expect(err).To(BeNil())
+
+			By("Refetching our Request...")
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      request.Name,
+				Namespace: request.Namespace,
+			}, request)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// VERIFY: The access ends when the window closes
+			Expect(request.Status.ExpiresAt).ToNot(BeNil())
+			Expect(request.Status.ExpiresAt.Time).To(BeTemporally("==", closes))
+			Expect(rctx.expiresAt).To(BeTemporally("==", closes))
+
+			// VERIFY: The clamping is explained in the decision
+			cond := meta.FindStatusCondition(
+				*request.GetStatus().GetConditions(),
+				string(v1alpha1.ConditionRequestDurationsValid.String()),
+			)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Message).To(ContainSubstring(fmt.Sprintf(
+				"because the allowed window closes at %s", closes.Format(time.RFC3339),
+			)))
+		})
+
+		It("verifyDuration() should clamp access outside of the allowed windows to nothing", func() {
+			builder.getDurationErr = nil
+			builder.getDurationResp = time.Hour
+			created := rctx.obj.GetCreationTimestamp().UTC()
+			template.Spec.AccessConfig.AllowedWindows = []v1alpha1.AccessWindow{{
+				Start: created.Add(time.Hour).Format("15:04"),
+				End:   created.Add(2 * time.Hour).Format("15:04"),
+			}}
+			defer func() { template.Spec.AccessConfig.AllowedWindows = nil }()
+
+			shouldEndReconcile, _, err := reconciler.verifyDuration(rctx, template)
+			Expect(shouldEndReconcile).To(BeFalse())
+			Expect(err).To(BeNil())
+
+			By("Refetching our Request...")
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      request.Name,
+				Namespace: request.Namespace,
+			}, request)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// VERIFY: The access is no longer valid
+			cond := meta.FindStatusCondition(
+				*request.GetStatus().GetConditions(),
+				string(v1alpha1.ConditionAccessStillValid.String()),
+			)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+
+			cond = meta.FindStatusCondition(
+				*request.GetStatus().GetConditions(),
+				string(v1alpha1.ConditionRequestDurationsValid.String()),
+			)
+			Expect(cond.Message).To(ContainSubstring("clamped to 0s because access starts outside of the allowedWindows"))
+		})
+
+		It("verifyDuration() should succeed, and determine the access is revoked", func() {
+			// The duration is still valid - but the request has been revoked
+			builder.getDurationErr = nil
+			builder.getDurationResp = time.Hour
+			obj := rctx.obj.(*v1alpha1.ExecAccessRequest)
+			obj.Spec.Revoked = true
+			obj.Spec.RevokedBy = &v1alpha1.RequesterInfo{Username: "security"}
+
+			shouldEndReconcile, _, err := reconciler.verifyDuration(rctx, template)
+
+			// VERIFY: No, do not end the reconcile - isAccessExpired() cleans up
+			Expect(shouldEndReconcile).To(BeFalse())
+			Expect(err).To(BeNil())
+
+			By("Refetching our Request...")
+			err = k8sClient.Get(ctx, types.NamespacedName{
+				Name:      request.Name,
+				Namespace: request.Namespace,
+			}, request)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// VERIFY: The revocation, and who did it, is recorded
+			cond := meta.FindStatusCondition(
+				*request.GetStatus().GetConditions(),
+				string(v1alpha1.ConditionAccessStillValid.String()),
+			)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal("Revoked"))
+			Expect(cond.Message).To(Equal("Access revoked by security"))
+		})
+	})
+
+	Context("requeueAtExpiry()", func() {
+		now := time.Now()
+
+		It("should leave the result alone if there is no expiry", func() {
+			result := requeueAtExpiry(ctrl.Result{RequeueAfter: time.Hour}, time.Time{}, now)
+			Expect(result.RequeueAfter).To(Equal(time.Hour))
+		})
+
+		It("should leave the result alone if the expiry has passed", func() {
+			result := requeueAtExpiry(ctrl.Result{}, now.Add(-time.Minute), now)
+			Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+		})
+
+		It("should requeue at the expiry if it comes first", func() {
+			result := requeueAtExpiry(
+				ctrl.Result{RequeueAfter: time.Hour}, now.Add(10*time.Minute), now,
+			)
+			Expect(result.RequeueAfter).To(Equal(10 * time.Minute))
+		})
+
+		It("should requeue at the expiry if no requeue was requested", func() {
+			result := requeueAtExpiry(ctrl.Result{}, now.Add(10*time.Minute), now)
+			Expect(result.RequeueAfter).To(Equal(10 * time.Minute))
+		})
+
+		It("should keep an earlier requeue", func() {
+			result := requeueAtExpiry(
+				ctrl.Result{RequeueAfter: 5 * time.Second}, now.Add(10*time.Minute), now,
+			)
+			Expect(result.RequeueAfter).To(Equal(5 * time.Second))
 		})
 	})
 })
@@ -0,0 +1,55 @@
+package requestcontroller
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/controllers/internal/ctrlrequeue"
+	"github.com/diranged/oz/internal/controllers/internal/status"
+)
+
+// verifyStartTime holds back the creation of the access resources until the
+// Spec.startTime of the request has been reached. Until then the request is
+// Scheduled - the ConditionAccessStarted condition is False, and the next
+// reconcile happens right at the start time.
+//
+// Requests without a Spec.startTime (or with one that is not after their
+// creation) start right away, and never get the ConditionAccessStarted
+// condition. Once the condition is True, it is never re-evaluated.
+func (r *RequestReconciler) verifyStartTime(
+	rctx *RequestContext,
+) (shouldEndReconcile bool, result ctrl.Result, resultErr error) {
+	if meta.IsStatusConditionTrue(
+		*rctx.obj.GetStatus().GetConditions(),
+		v1alpha1.ConditionAccessStarted.String(),
+	) {
+		rctx.log.V(1).Info("Access has already started")
+		return false, result, nil
+	}
+
+	startTime := rctx.obj.GetStartTime()
+	created := rctx.obj.GetCreationTimestamp()
+	if !startTime.After(created.Time) {
+		rctx.log.V(1).Info("Request does not have a future start time")
+		return false, result, nil
+	}
+
+	if untilStart := time.Until(startTime); untilStart > 0 {
+		msg := fmt.Sprintf("Access is scheduled to start at %s", startTime.UTC().Format(time.RFC3339))
+		rctx.log.Info(msg)
+		if err := status.SetAccessScheduled(rctx.Context, r, rctx.obj, msg); err != nil {
+			return true, result, err
+		}
+		result, resultErr = ctrlrequeue.RequeueAfter(untilStart)
+		return true, result, resultErr
+	}
+
+	return false, result, status.SetAccessStarted(
+		rctx.Context, r, rctx.obj,
+		fmt.Sprintf("Access started at %s", startTime.UTC().Format(time.RFC3339)),
+	)
+}
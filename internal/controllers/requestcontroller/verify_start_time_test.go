@@ -0,0 +1,141 @@
+package requestcontroller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/testing/utils"
+)
+
+var _ = Describe("RequestReconciler", Ordered, func() {
+	/*
+		verifyStartTime() Tests
+	*/
+	Context("verifyStartTime()", func() {
+		var (
+			ctx        = context.Background()
+			ns         *v1.Namespace
+			request    *v1alpha1.ExecAccessRequest
+			reconciler *RequestReconciler
+			rctx       *RequestContext
+		)
+
+		createRequest := func(startTime *metav1.Time) {
+			request = &v1alpha1.ExecAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.ExecAccessRequestSpec{
+					TemplateName: "unused",
+					StartTime:    startTime,
+				},
+			}
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			rctx = newRequestContext(
+				ctx,
+				reconciler.RequestType,
+				reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Name:      request.GetName(),
+						Namespace: request.GetNamespace(),
+					},
+				},
+			)
+			Expect(reconciler.fetchRequestObject(rctx)).To(Succeed())
+		}
+
+		getCondition := func() *metav1.Condition {
+			err := k8sClient.Get(ctx, types.NamespacedName{
+				Name:      request.Name,
+				Namespace: request.Namespace,
+			}, request)
+			Expect(err).To(Not(HaveOccurred()))
+			return meta.FindStatusCondition(
+				*request.GetStatus().GetConditions(),
+				v1alpha1.ConditionAccessStarted.String(),
+			)
+		}
+
+		BeforeEach(func() {
+			By("Should have a namespace to execute tests in")
+			ns = &v1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: testutil.RandomString(8),
+				},
+			}
+			err := k8sClient.Create(ctx, ns)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Creating the RequestReconciler")
+			reconciler = &RequestReconciler{
+				Client:                 k8sClient,
+				Scheme:                 k8sClient.Scheme(),
+				APIReader:              k8sClient,
+				RequestType:            &v1alpha1.ExecAccessRequest{},
+				Builder:                &mockBuilder{},
+				ReconciliationInterval: time.Minute,
+			}
+		})
+
+		AfterEach(func() {
+			By("Should delete the namespace")
+			err := k8sClient.Delete(ctx, ns)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("verifyStartTime() should continue if the request has no start time", func() {
+			createRequest(nil)
+
+			shouldReturn, result, err := reconciler.verifyStartTime(rctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeFalse())
+			Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+			Expect(getCondition()).To(BeNil())
+		})
+
+		It("verifyStartTime() should keep the request scheduled until the start time", func() {
+			startTime := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+			createRequest(&startTime)
+
+			By("Being scheduled")
+			shouldReturn, result, err := reconciler.verifyStartTime(rctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeTrue())
+			Expect(result.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+			Expect(result.RequeueAfter).To(BeNumerically("<=", time.Hour))
+			cond := getCondition()
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal("Scheduled"))
+			Expect(cond.Message).To(ContainSubstring(startTime.UTC().Format(time.RFC3339)))
+
+			By("Measuring the uptime from the start time")
+			Expect(rctx.obj.GetStartTime()).To(BeTemporally("==", startTime.Time))
+			Expect(rctx.obj.GetUptime()).To(BeNumerically("<", 0))
+
+			By("Reaching the start time")
+			past := metav1.NewTime(time.Now().Add(-time.Second).Truncate(time.Second))
+			rctx.obj.(*v1alpha1.ExecAccessRequest).Spec.StartTime = &past
+			rctx.obj.(*v1alpha1.ExecAccessRequest).CreationTimestamp = metav1.NewTime(past.Add(-time.Hour))
+			shouldReturn, result, err = reconciler.verifyStartTime(rctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shouldReturn).To(BeFalse())
+			Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+			cond = getCondition()
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Message).To(ContainSubstring("Access started at"))
+		})
+	})
+})
@@ -23,6 +23,18 @@ import (
 //+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=podaccesstemplates/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=podaccesstemplates/finalizers,verbs=update
 
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=portforwardaccesstemplates,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=portforwardaccesstemplates/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=portforwardaccesstemplates/finalizers,verbs=update
+
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=logaccesstemplates,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=logaccesstemplates/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=logaccesstemplates/finalizers,verbs=update
+
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=ephemeralcontaineraccesstemplates,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=ephemeralcontaineraccesstemplates/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=crds.wizardofoz.co,resources=ephemeralcontaineraccesstemplates/finalizers,verbs=update
+
 //+kubebuilder:rbac:groups=apps,resources=deployments;daemonsets;statefulsets,verbs=get;list;watch
 //+kubebuilder:rbac:groups=argoproj.io,resources=rollouts,verbs=get;list;watch
 
@@ -109,6 +121,13 @@ func (r *TemplateReconciler) reconcile(rctx *RequestContext) (ctrl.Result, error
 	// TODO:
 	// VERIFICATION: Ensure that the allowedGroups match valid group name strings
 
+	// USAGE: Record the Access Requests made against the template. The
+	// Status is saved along with the Ready state below.
+	err = r.updateUsage(rctx, time.Now())
+	if err != nil {
+		return ctrlrequeue.RequeueError(err)
+	}
+
 	// FINAL: Set Status.Ready state
 	err = status.SetReadyStatus(rctx, r, rctx.obj)
 	if err != nil {
@@ -1,14 +1,64 @@
 package templatecontroller
 
 import (
-	ctrlutil "github.com/diranged/oz/internal/controllers/internal/utils"
+	"context"
+
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	ctrlutil "github.com/diranged/oz/internal/controllers/internal/utils"
 )
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *TemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(r.TemplateType).
-		WithEventFilter(ctrlutil.IgnoreStatusUpdatesAndDeletion()).
-		Complete(r)
+	bldr := ctrl.NewControllerManagedBy(mgr).
+		For(r.TemplateType, builder.WithPredicates(ctrlutil.IgnoreStatusUpdatesAndDeletion()))
+
+	// Access Requests coming and going (or changing their expiry) are
+	// reflected in the usage statistics of the template they reference.
+	if r.RequestType != nil {
+		bldr = bldr.Watches(
+			r.RequestType,
+			handler.EnqueueRequestsFromMapFunc(requestToTemplate),
+			builder.WithPredicates(requestUsageChanged()),
+		)
+	}
+
+	return bldr.Complete(r)
+}
+
+// requestToTemplate maps an Access Request back to the Access Template that it
+// references.
+func requestToTemplate(_ context.Context, obj client.Object) []ctrl.Request {
+	req, ok := obj.(v1alpha1.IRequestResource)
+	if !ok || req.GetTemplateName() == "" {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: client.ObjectKey{
+		Namespace: req.GetNamespace(),
+		Name:      req.GetTemplateName(),
+	}}}
+}
+
+// requestUsageChanged filters the Access Request updates down to those that
+// change what is recorded in the TemplateUsage - the Access Requests make many
+// other Status updates during their reconciliation that we do not care about.
+func requestUsageChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldReq, okOld := e.ObjectOld.(v1alpha1.IRequestResource)
+			newReq, okNew := e.ObjectNew.(v1alpha1.IRequestResource)
+			if !okOld || !okNew {
+				return false
+			}
+			return oldReq.IsRevoked() != newReq.IsRevoked() ||
+				(oldReq.GetDeletionTimestamp() == nil) != (newReq.GetDeletionTimestamp() == nil) ||
+				!expiresAt(oldReq).Equal(expiresAt(newReq))
+		},
+	}
 }
@@ -41,6 +41,11 @@ type TemplateReconciler struct {
 	// is going to Watch for, and how to retrive them from the Kubernetes API.
 	TemplateType v1alpha1.ITemplateResource
 
+	// RequestType is the "Kind" of Access Request that is made against the
+	// TemplateType. The requests are watched to keep the usage statistics in
+	// the Status of the templates up to date. If nil, they are not tracked.
+	RequestType v1alpha1.IRequestResource
+
 	// Builder provides an IBuilder compatible object for handling the RequestType reconciliation
 	Builder builders.IBuilder
 
@@ -52,6 +57,7 @@ type TemplateReconciler struct {
 func NewTemplateReconciler(
 	mgr manager.Manager,
 	res v1alpha1.ITemplateResource,
+	req v1alpha1.IRequestResource,
 	interval int,
 ) *TemplateReconciler {
 	return &TemplateReconciler{
@@ -60,6 +66,7 @@ func NewTemplateReconciler(
 		APIReader:              mgr.GetAPIReader(),
 		recorder:               mgr.GetEventRecorder(controllers.EventRecorderName),
 		TemplateType:           res,
+		RequestType:            req,
 		ReconciliationInterval: time.Duration(interval) * time.Minute,
 	}
 }
@@ -0,0 +1,70 @@
+package templatecontroller
+
+import (
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+)
+
+// updateUsage populates the Status.activeRequestCount and
+// Status.activeRequests fields of the template from the live Access Requests
+// that reference it. The Status.usageCount and Status.lastUsedTime fields are
+// maintained by the RequestReconciler as each request is made.
+//
+// The Status is only updated in memory - it is pushed to Kubernetes by the
+// final SetReadyStatus() call of the reconcile.
+//
+// Returns:
+//   - An "error" only if the Access Requests could not be listed
+func (r *TemplateReconciler) updateUsage(rctx *RequestContext, now time.Time) error {
+	if r.RequestType == nil {
+		return nil
+	}
+	templateStatus, ok := rctx.obj.GetStatus().(v1alpha1.ITemplateStatus)
+	if !ok {
+		return nil
+	}
+	usage := templateStatus.GetUsage()
+
+	requests, err := v1alpha1.ListLiveRequests(
+		rctx.Context, r.Client, r.RequestType, rctx.obj.GetNamespace(), rctx.obj.GetName(), now,
+	)
+	if err != nil {
+		return err
+	}
+
+	activeRequests := []v1alpha1.ActiveRequest{}
+	for _, req := range requests {
+		active := v1alpha1.ActiveRequest{
+			Name:      req.GetName(),
+			UID:       req.GetUID(),
+			ExpiresAt: expiresAt(req),
+		}
+		if requester := req.GetRequestedBy(); requester != nil {
+			active.RequestedBy = requester.Username
+		}
+		activeRequests = append(activeRequests, active)
+	}
+
+	// Keep the list in a stable order, so that it only changes when the
+	// requests do.
+	sort.Slice(activeRequests, func(i, j int) bool {
+		return activeRequests[i].Name < activeRequests[j].Name
+	})
+
+	usage.ActiveRequests = activeRequests
+	usage.ActiveRequestCount = len(activeRequests)
+	return nil
+}
+
+// expiresAt returns the Status.expiresAt field of the Access Request, or nil
+// if it has not been set yet.
+func expiresAt(req v1alpha1.IRequestResource) *metav1.Time {
+	if reqStatus, ok := req.GetStatus().(v1alpha1.IRequestStatus); ok {
+		return reqStatus.GetExpiresAt()
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package templatecontroller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/diranged/oz/internal/api/v1alpha1"
+	"github.com/diranged/oz/internal/testing/utils"
+)
+
+var _ = Describe("TemplateReconciler", Ordered, func() {
+	Context("updateUsage()", func() {
+		var (
+			ctx        = context.Background()
+			ns         *corev1.Namespace
+			template   *v1alpha1.ExecAccessTemplate
+			reconciler *TemplateReconciler
+		)
+
+		newRequest := func(templateName string, requester *v1alpha1.RequesterInfo) *v1alpha1.ExecAccessRequest {
+			req := &v1alpha1.ExecAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.ExecAccessRequestSpec{
+					TemplateName: templateName,
+					RequestedBy:  requester,
+				},
+			}
+			Expect(k8sClient.Create(ctx, req)).To(Succeed())
+			return req
+		}
+
+		runUpdateUsage := func(now time.Time) *v1alpha1.TemplateUsage {
+			rctx := newRequestContext(
+				ctx,
+				reconciler.TemplateType,
+				reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Name:      template.GetName(),
+						Namespace: template.GetNamespace(),
+					},
+				},
+			)
+			Expect(reconciler.fetchRequestObject(rctx)).To(Succeed())
+			Expect(reconciler.updateUsage(rctx, now)).To(Succeed())
+
+			// Save the Status, as the final SetReadyStatus() call would
+			Expect(k8sClient.Status().Update(ctx, rctx.obj)).To(Succeed())
+			return rctx.obj.GetStatus().(v1alpha1.ITemplateStatus).GetUsage()
+		}
+
+		BeforeAll(func() {
+			By("Should have a namespace to execute tests in")
+			ns = &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: testutil.RandomString(8),
+				},
+			}
+			Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+
+			By("Should have an ExecAccessTemplate built to test against")
+			template = &v1alpha1.ExecAccessTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testutil.RandomString(8),
+					Namespace: ns.GetName(),
+				},
+				Spec: v1alpha1.ExecAccessTemplateSpec{
+					AccessConfig: v1alpha1.AccessConfig{
+						AllowedGroups:   []string{"foo"},
+						DefaultDuration: "1h",
+						MaxDuration:     "2h",
+					},
+					ControllerTargetRef: &v1alpha1.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "junk",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, template)).To(Succeed())
+
+			By("Creating the TemplateReconciler")
+			reconciler = &TemplateReconciler{
+				Client:                 k8sClient,
+				APIReader:              k8sClient,
+				Scheme:                 k8sClient.Scheme(),
+				TemplateType:           &v1alpha1.ExecAccessTemplate{},
+				RequestType:            &v1alpha1.ExecAccessRequest{},
+				recorder:               events.NewFakeRecorder(50),
+				ReconciliationInterval: 0,
+			}
+		})
+
+		AfterAll(func() {
+			By("Should delete the namespace")
+			Expect(k8sClient.Delete(ctx, ns)).To(Succeed())
+		})
+
+		It("updateUsage() should track the requests made against the template", func() {
+			By("Creating requests for this, and another, template")
+			alice := newRequest(template.GetName(), &v1alpha1.RequesterInfo{Username: "alice"})
+			bob := newRequest(template.GetName(), &v1alpha1.RequesterInfo{Username: "bob"})
+			newRequest("some-other-template", nil)
+
+			// VERIFY: Both requests are active. Counting them is left to the
+			// RequestReconciler.
+			usage := runUpdateUsage(time.Now())
+			Expect(usage.ActiveRequestCount).To(Equal(2))
+			Expect(usage.UsageCount).To(BeZero())
+			Expect(usage.LastUsedTime).To(BeNil())
+			Expect(usage.ActiveRequests).To(ContainElement(v1alpha1.ActiveRequest{
+				Name:        alice.GetName(),
+				UID:         alice.GetUID(),
+				RequestedBy: "alice",
+			}))
+
+			// VERIFY: Requests are only listed once
+			usage = runUpdateUsage(time.Now())
+			Expect(usage.ActiveRequestCount).To(Equal(2))
+			Expect(usage.ActiveRequests).To(HaveLen(2))
+
+			By("Revoking one request, and expiring the other")
+			alice.Spec.Revoked = true
+			Expect(k8sClient.Update(ctx, alice)).To(Succeed())
+			bob.Status.SetExpiresAt(metav1.NewTime(time.Now().Add(time.Minute)))
+			Expect(k8sClient.Status().Update(ctx, bob)).To(Succeed())
+
+			// VERIFY: The expiry of the remaining request is recorded
+			usage = runUpdateUsage(time.Now())
+			Expect(usage.ActiveRequestCount).To(Equal(1))
+			Expect(usage.ActiveRequests[0].Name).To(Equal(bob.GetName()))
+			Expect(usage.ActiveRequests[0].ExpiresAt).ToNot(BeNil())
+
+			// VERIFY: Nothing is active once it has expired
+			usage = runUpdateUsage(time.Now().Add(time.Hour))
+			Expect(usage.ActiveRequestCount).To(Equal(0))
+			Expect(usage.ActiveRequests).To(BeEmpty())
+
+			By("Creating a third request")
+			newRequest(template.GetName(), nil)
+			usage = runUpdateUsage(time.Now().Add(time.Hour))
+			Expect(usage.ActiveRequestCount).To(Equal(1))
+		})
+
+		It("updateUsage() should do nothing without a RequestType", func() {
+			reconciler.RequestType = nil
+			defer func() { reconciler.RequestType = &v1alpha1.ExecAccessRequest{} }()
+
+			newRequest(template.GetName(), nil)
+			usage := runUpdateUsage(time.Now().Add(time.Hour))
+			Expect(usage.ActiveRequestCount).To(Equal(1))
+		})
+
+		It("requestToTemplate() should map requests to their template", func() {
+			req := &v1alpha1.ExecAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "req", Namespace: "ns"},
+				Spec:       v1alpha1.ExecAccessRequestSpec{TemplateName: "tmpl"},
+			}
+			Expect(requestToTemplate(ctx, req)).To(Equal([]reconcile.Request{{
+				NamespacedName: types.NamespacedName{Namespace: "ns", Name: "tmpl"},
+			}}))
+		})
+
+		It("requestUsageChanged() should only pass relevant updates", func() {
+			oldReq := &v1alpha1.ExecAccessRequest{}
+			newReq := oldReq.DeepCopy()
+			pred := requestUsageChanged()
+
+			// VERIFY: Condition updates are ignored
+			newReq.Status.SetReady(true)
+			Expect(pred.Update(event.UpdateEvent{ObjectOld: oldReq, ObjectNew: newReq})).To(BeFalse())
+
+			// VERIFY: Changes to the expiry are not
+			newReq.Status.SetExpiresAt(metav1.Now())
+			Expect(pred.Update(event.UpdateEvent{ObjectOld: oldReq, ObjectNew: newReq})).To(BeTrue())
+
+			// VERIFY: Neither are revocations
+			newReq = oldReq.DeepCopy()
+			newReq.Spec.Revoked = true
+			Expect(pred.Update(event.UpdateEvent{ObjectOld: oldReq, ObjectNew: newReq})).To(BeTrue())
+		})
+	})
+})
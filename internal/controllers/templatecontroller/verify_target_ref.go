@@ -16,6 +16,12 @@ func (r *TemplateReconciler) verifyTargetRef(rctx *RequestContext) error {
 	// eventStr := "TargetRefVerified"
 	rctx.log.Info("Beginning TargetRef Verification")
 
+	// Templates that launch their own Pods (eg. PodAccessTemplate.spec.podSpec)
+	// have no controller to verify.
+	if rctx.obj.GetTargetRef() == nil {
+		return status.SetTargetRefExists(rctx.Context, r, rctx.obj, "No controllerTargetRef configured")
+	}
+
 	// https://blog.gripdev.xyz/2020/07/20/k8s-operator-with-dynamic-crds-using-controller-runtime-no-structs/
 	targetRef := rctx.obj.GetTargetRef().GetObject()
 
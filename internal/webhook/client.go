@@ -0,0 +1,26 @@
+package webhook
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// clientKey is the key under which the Manager's client is stored in the
+// context passed into the `Default()` and `Validate*()` functions.
+type clientKey struct{}
+
+// NewContextWithClient returns a copy of ctx carrying the supplied client.
+// The webhook handlers use this to hand the Manager's client to the objects
+// that they are admitting, so that those objects can look up the resources
+// that they reference (eg. an Access Template).
+func NewContextWithClient(ctx context.Context, cl client.Client) context.Context {
+	return context.WithValue(ctx, clientKey{}, cl)
+}
+
+// ClientFromContext returns the client stored in ctx by
+// NewContextWithClient(), or nil if there is none.
+func ClientFromContext(ctx context.Context) client.Client {
+	cl, _ := ctx.Value(clientKey{}).(client.Client)
+	return cl
+}
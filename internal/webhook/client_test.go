@@ -0,0 +1,23 @@
+package webhook
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Webhook", func() {
+	Context("Client", func() {
+		It("NewContextWithClient()/ClientFromContext()", func() {
+			Expect(ClientFromContext(context.TODO())).To(BeNil())
+
+			cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+			ctx := NewContextWithClient(context.TODO(), cl)
+			Expect(ClientFromContext(ctx)).To(BeIdenticalTo(cl))
+		})
+	})
+})
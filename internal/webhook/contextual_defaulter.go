@@ -11,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
@@ -21,12 +22,13 @@ import (
 // supplied the request resource, but also the request context in the form of
 // an
 // [`admission.Request`](https://github.com/kubernetes-sigs/controller-runtime/blob/v0.18.3/pkg/webhook/admission/webhook.go#L43-L66)
-// object.
+// object. The supplied context carries the Manager's client, see
+// ClientFromContext().
 //
 // Modified from https://github.com/kubernetes-sigs/controller-runtime/blob/v0.18.3/pkg/webhook/admission/defaulter_custom.go#L31-L34
 type IContextuallyDefaultableObject interface {
 	runtime.Object
-	Default(req admission.Request) error
+	Default(ctx context.Context, req admission.Request) error
 }
 
 // RegisterContextualDefaulter leverages many of the patterns and code from the
@@ -45,7 +47,11 @@ func RegisterContextualDefaulter(
 
 	// Create a Webhook{} resource with our Handler.
 	mwh := &admission.Webhook{
-		Handler: &defaulterForType{object: obj, decoder: admission.NewDecoder(mgr.GetScheme())},
+		Handler: &defaulterForType{
+			object:  obj,
+			decoder: admission.NewDecoder(mgr.GetScheme()),
+			client:  mgr.GetClient(),
+		},
 	}
 
 	// Insert the path into the webhook server and point it at our mutating
@@ -64,13 +70,14 @@ func RegisterContextualDefaulter(
 type defaulterForType struct {
 	object  IContextuallyDefaultableObject
 	decoder admission.Decoder
+	client  client.Client
 }
 
 // decoding the request into an
 // [`admission.Request`](https://pkg.go.dev/k8s.io/api/admission/v1#AdmissionRequest)
 // object, calling the `Default()` function on that object, and then returning
 // back the patched response to the API server.
-func (h *defaulterForType) Handle(_ context.Context, req admission.Request) admission.Response {
+func (h *defaulterForType) Handle(ctx context.Context, req admission.Request) admission.Response {
 	// https://github.com/kubernetes-sigs/controller-runtime/blob/v0.18.3/pkg/webhook/admission/defaulter.go#L49-L54
 	if h.decoder == nil {
 		panic("decoder should never be nil")
@@ -102,7 +109,7 @@ func (h *defaulterForType) Handle(_ context.Context, req admission.Request) admi
 	// Default the object
 	//
 	// orig: https://github.com/kubernetes-sigs/controller-runtime/blob/v0.13.1/pkg/webhook/admission/defaulter.go#L78-L83
-	err := obj.Default(req)
+	err := obj.Default(NewContextWithClient(ctx, h.client), req)
 	if err != nil {
 		var apiStatus apierrors.APIStatus
 		if errors.As(err, &apiStatus) {
@@ -149,7 +149,7 @@ type TestDefaulterList struct{}
 func (*TestDefaulterList) GetObjectKind() schema.ObjectKind { return nil }
 func (*TestDefaulterList) DeepCopyObject() runtime.Object   { return nil }
 
-func (d *TestDefaulter) Default(req admission.Request) error {
+func (d *TestDefaulter) Default(_ context.Context, req admission.Request) error {
 	if req.UserInfo.Username != "" {
 		d.Requestor = req.UserInfo.Username
 		return nil
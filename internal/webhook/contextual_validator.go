@@ -10,6 +10,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
@@ -20,7 +21,8 @@ import (
 // supplied the request resource, but also the request context in the form of
 // an
 // [`admission.Request`](https://github.com/kubernetes-sigs/controller-runtime/blob/v0.18.3/pkg/webhook/admission/webhook.go#L42C1-L65)
-// object.
+// object. The supplied context carries the Manager's client, see
+// ClientFromContext().
 //
 // Modified from https://github.com/kubernetes-sigs/controller-runtime/blob/v0.18.3/pkg/webhook/admission/defaulter_custom.go#L31-L34
 type IContextuallyValidatableObject interface {
@@ -28,17 +30,17 @@ type IContextuallyValidatableObject interface {
 	// ValidateCreate validates the object on creation.
 	// The optional warnings will be added to the response as warning messages.
 	// Return an error if the object is invalid.
-	ValidateCreate(req admission.Request) (warnings admission.Warnings, err error)
+	ValidateCreate(ctx context.Context, req admission.Request) (warnings admission.Warnings, err error)
 
 	// ValidateUpdate validates the object on update. The oldObj is the object before the update.
 	// The optional warnings will be added to the response as warning messages.
 	// Return an error if the object is invalid.
-	ValidateUpdate(req admission.Request, old runtime.Object) (warnings admission.Warnings, err error)
+	ValidateUpdate(ctx context.Context, req admission.Request, old runtime.Object) (warnings admission.Warnings, err error)
 
 	// ValidateDelete validates the object on deletion.
 	// The optional warnings will be added to the response as warning messages.
 	// Return an error if the object is invalid.
-	ValidateDelete(req admission.Request) (warnings admission.Warnings, err error)
+	ValidateDelete(ctx context.Context, req admission.Request) (warnings admission.Warnings, err error)
 }
 
 // RegisterContextualValidator leverages many of the patterns and code from the
@@ -57,7 +59,11 @@ func RegisterContextualValidator(
 
 	// Create a Webhook{} resource with our Handler.
 	mwh := &admission.Webhook{
-		Handler: &validatorForType{object: obj, decoder: admission.NewDecoder(mgr.GetScheme())},
+		Handler: &validatorForType{
+			object:  obj,
+			decoder: admission.NewDecoder(mgr.GetScheme()),
+			client:  mgr.GetClient(),
+		},
 	}
 
 	// Insert the path into the webhook server and point it at our mutating
@@ -76,6 +82,7 @@ func RegisterContextualValidator(
 type validatorForType struct {
 	object  IContextuallyValidatableObject
 	decoder admission.Decoder
+	client  client.Client
 }
 
 // Handle manages the inbound request from the API server. It's responsible for
@@ -86,7 +93,7 @@ type validatorForType struct {
 // Handle handles admission requests.
 //
 // revive:disable:cyclomatic Replication of existing code in Controller-Runtime
-func (h *validatorForType) Handle(_ context.Context, req admission.Request) admission.Response {
+func (h *validatorForType) Handle(ctx context.Context, req admission.Request) admission.Response {
 	// https://github.com/kubernetes-sigs/controller-runtime/blob/v0.18.3/pkg/webhook/admission/validator.go#L69-L74
 	if h.decoder == nil {
 		panic("decoder should never be nil")
@@ -97,6 +104,7 @@ func (h *validatorForType) Handle(_ context.Context, req admission.Request) admi
 
 	// Get the object in the request
 	obj := h.object.DeepCopyObject().(IContextuallyValidatableObject)
+	ctx = NewContextWithClient(ctx, h.client)
 
 	var err error
 	var warnings []string
@@ -110,7 +118,7 @@ func (h *validatorForType) Handle(_ context.Context, req admission.Request) admi
 			return admission.Errored(http.StatusBadRequest, err)
 		}
 
-		warnings, err = obj.ValidateCreate(req)
+		warnings, err = obj.ValidateCreate(ctx, req)
 	case v1.Update:
 		oldObj := obj.DeepCopyObject()
 
@@ -123,7 +131,7 @@ func (h *validatorForType) Handle(_ context.Context, req admission.Request) admi
 			return admission.Errored(http.StatusBadRequest, err)
 		}
 
-		warnings, err = obj.ValidateUpdate(req, oldObj)
+		warnings, err = obj.ValidateUpdate(ctx, req, oldObj)
 	case v1.Delete:
 		// In reference to PR: https://github.com/kubernetes/kubernetes/pull/76346
 		// OldObject contains the object being deleted
@@ -132,7 +140,7 @@ func (h *validatorForType) Handle(_ context.Context, req admission.Request) admi
 			return admission.Errored(http.StatusBadRequest, err)
 		}
 
-		warnings, err = obj.ValidateDelete(req)
+		warnings, err = obj.ValidateDelete(ctx, req)
 	default:
 		return admission.Errored(http.StatusBadRequest, fmt.Errorf("unknown operation %q", req.Operation))
 	}
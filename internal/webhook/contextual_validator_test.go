@@ -270,21 +270,21 @@ type TestValidatorList struct{}
 func (*TestValidatorList) GetObjectKind() schema.ObjectKind { return nil }
 func (*TestValidatorList) DeepCopyObject() runtime.Object   { return nil }
 
-func (d *TestValidator) ValidateCreate(req admission.Request) (warnings admission.Warnings, err error) {
+func (d *TestValidator) ValidateCreate(_ context.Context, req admission.Request) (warnings admission.Warnings, err error) {
 	if d.Requestor != req.UserInfo.DeepCopy().Username {
 		return nil, errors.New("must have userinfo context")
 	}
 	return nil, nil
 }
 
-func (d *TestValidator) ValidateDelete(_ admission.Request) (warnings admission.Warnings, err error) {
+func (d *TestValidator) ValidateDelete(_ context.Context, _ admission.Request) (warnings admission.Warnings, err error) {
 	if d.Requestor == "" {
 		return nil, errors.New("cannot delete")
 	}
 	return nil, nil
 }
 
-func (d *TestValidator) ValidateUpdate(_ admission.Request, oldObj runtime.Object) (warnings admission.Warnings, err error) {
+func (d *TestValidator) ValidateUpdate(_ context.Context, _ admission.Request, oldObj runtime.Object) (warnings admission.Warnings, err error) {
 	old := oldObj.(*TestValidator)
 	if d.Requestor != old.Requestor {
 		return nil, errors.New("requestor field immutable")
@@ -4,5 +4,7 @@
 // [`admission.Request`](https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/webhook/admission/webhook.go#L48-L50)
 // object into the `Default()`, `ValidateCreate()`, `ValidateUpdate()` and
 // `ValidateDelete()` functions to provide more context to these functions for
-// making their decisions.
+// making their decisions. The `context.Context` passed into those functions
+// carries the Manager's client (see ClientFromContext()), so that they can look
+// up the resources that an object references.
 package webhook